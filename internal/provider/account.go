@@ -4,10 +4,13 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 var _ datasource.DataSource = &Account{}
@@ -21,11 +24,14 @@ type Account struct {
 }
 
 type AccountModel struct {
-	AccountID    types.Int64  `tfsdk:"account_id"`
-	AccountName  types.String `tfsdk:"account_name"`
-	AccountTitle types.String `tfsdk:"account_title"`
-	AccountType  types.String `tfsdk:"account_type"`
-	Account      types.Map    `tfsdk:"account"`
+	AccountID            types.Int64  `tfsdk:"account_id"`
+	AccountName          types.String `tfsdk:"account_name"`
+	AccountTitle         types.String `tfsdk:"account_title"`
+	AccountType          types.String `tfsdk:"account_type"`
+	Account              types.Map    `tfsdk:"account"`
+	Reason               types.String `tfsdk:"reason"`
+	AccessRequestReason  types.String `tfsdk:"access_request_reason"`
+	AccessRequestTimeout types.Int64  `tfsdk:"access_request_timeout"`
 }
 
 func (d *Account) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -62,6 +68,18 @@ func (d *Account) Schema(ctx context.Context, req datasource.SchemaRequest, resp
 				Computed:            true,
 				MarkdownDescription: "A map containing account attributes as keys and their corresponding values.",
 			},
+			"reason": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Reason recorded in the Securden audit trail for reading the account. Overrides the provider's `default_reason`.",
+			},
+			"access_request_reason": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "When the account requires an access request, raise one with this reason and wait for it to be approved before reading the account. Requires `account_id`.",
+			},
+			"access_request_timeout": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: fmt.Sprintf("Seconds to wait for the access request to be approved. Defaults to %d.", default_access_request_timeout),
+			},
 		},
 	}
 }
@@ -82,6 +100,9 @@ func (d *Account) Configure(ctx context.Context, req datasource.ConfigureRequest
 }
 
 func (d *Account) Create(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if !check_configured(d.client, req, resp) {
+		return
+	}
 	var account AccountModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &account)...)
 	var account_id int64
@@ -95,15 +116,31 @@ func (d *Account) Create(ctx context.Context, req datasource.ReadRequest, resp *
 	var data AccountModel
 	var code int
 	var message string
-	data, code, message = get_account(ctx, account_id, account_name, account_title, account_type)
+	data, code, message = get_account(ctx, account_id, account_name, account_title, account_type, account.Reason.ValueString())
+	if code == 403 && !account.AccessRequestReason.IsNull() {
+		if !request_account_access(ctx, account, &resp.Diagnostics) {
+			return
+		}
+		data, code, message = get_account(ctx, account_id, account_name, account_title, account_type, account.Reason.ValueString())
+	}
+	if code == 403 {
+		resp.Diagnostics.AddWarning(fmt.Sprintf("%d - %s", code, message), "The account may require an access request. Set access_request_reason to raise one and wait for approval.")
+		return
+	}
 	if code != 200 {
 		resp.Diagnostics.AddWarning(fmt.Sprintf("%d - %s", code, message), "")
 		return
 	}
+	data.Reason = account.Reason
+	data.AccessRequestReason = account.AccessRequestReason
+	data.AccessRequestTimeout = account.AccessRequestTimeout
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (d *Account) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if !check_configured(d.client, req, resp) {
+		return
+	}
 	var account AccountModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &account)...)
 	var account_id int64
@@ -117,10 +154,54 @@ func (d *Account) Read(ctx context.Context, req datasource.ReadRequest, resp *da
 	var data AccountModel
 	var code int
 	var message string
-	data, code, message = get_account(ctx, account_id, account_name, account_title, account_type)
+	data, code, message = get_account(ctx, account_id, account_name, account_title, account_type, account.Reason.ValueString())
+	if code == 403 && !account.AccessRequestReason.IsNull() {
+		if !request_account_access(ctx, account, &resp.Diagnostics) {
+			return
+		}
+		data, code, message = get_account(ctx, account_id, account_name, account_title, account_type, account.Reason.ValueString())
+	}
+	if code == 403 {
+		resp.Diagnostics.AddWarning(fmt.Sprintf("%d - %s", code, message), "The account may require an access request. Set access_request_reason to raise one and wait for approval.")
+		return
+	}
 	if code != 200 {
 		resp.Diagnostics.AddWarning(fmt.Sprintf("%d - %s", code, message), "")
 		return
 	}
+	data.Reason = account.Reason
+	data.AccessRequestReason = account.AccessRequestReason
+	data.AccessRequestTimeout = account.AccessRequestTimeout
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
+
+// request_account_access raises an access request for a protected account and
+// waits for it to be approved. The request ID is added as a warning before
+// waiting, so it is reported even when the wait times out, and is logged so it
+// can be followed with TF_LOG while Terraform is waiting.
+func request_account_access(ctx context.Context, account AccountModel, diags *diag.Diagnostics) bool {
+	if account.AccountID.IsNull() {
+		diags.AddError("Missing Account ID", "account_id must be set to raise an access request.")
+		return false
+	}
+	params := make(map[string]any)
+	setParam(params, "account_id", account.AccountID)
+	setParam(params, "reason", account.AccessRequestReason)
+	requestID, code, message := raise_access_request_function(ctx, params)
+	if code != 200 {
+		diags.AddError(fmt.Sprintf("%d - %s", code, message), "")
+		return false
+	}
+	timeout := default_access_request_timeout
+	if !account.AccessRequestTimeout.IsNull() {
+		timeout = account.AccessRequestTimeout.ValueInt64()
+	}
+	diags.AddWarning("Access Request Raised", fmt.Sprintf("Access request %s was raised for account %d.", requestID, account.AccountID.ValueInt64()))
+	tflog.Info(ctx, "Waiting for Securden access request approval", map[string]any{"request_id": requestID})
+	code, message = wait_for_access_request(ctx, requestID, time.Duration(timeout)*time.Second)
+	if code != 200 {
+		diags.AddError("Access Request Not Approved", message)
+		return false
+	}
+	return true
+}
@@ -0,0 +1,264 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &AccountAttachment{}
+var _ resource.ResourceWithValidateConfig = &AccountAttachment{}
+var _ resource.ResourceWithModifyPlan = &AccountAttachment{}
+
+func account_attachment() resource.Resource {
+	return &AccountAttachment{}
+}
+
+type AccountAttachment struct {
+	client *http.Client
+}
+
+type AccountAttachmentModel struct {
+	ID            types.Int64  `tfsdk:"id"`
+	AccountID     types.Int64  `tfsdk:"account_id"`
+	Name          types.String `tfsdk:"name"`
+	Source        types.String `tfsdk:"source"`
+	ContentBase64 types.String `tfsdk:"content_base64"`
+	ContentSHA256 types.String `tfsdk:"content_sha256"`
+	Size          types.Int64  `tfsdk:"size"`
+}
+
+func (r *AccountAttachment) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_account_attachment"
+}
+
+func (r *AccountAttachment) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Uploads a file attachment to a Securden account. Any change to the file replaces the attachment.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Unique identifier of the attachment.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"account_id": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "Unique identifier of the account the file is attached to.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "File name of the attachment, e.g. `kubeconfig.yaml`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"source": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Path of a local file to upload. Exactly one of `source` or `content_base64` must be set.",
+			},
+			"content_base64": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				MarkdownDescription: "Base64 encoded content to upload, e.g. `filebase64(\"keystore.jks\")`.",
+			},
+			"content_sha256": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Hex encoded SHA256 checksum of the uploaded content.",
+			},
+			"size": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Size of the attachment in bytes.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *AccountAttachment) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var attachment AccountAttachmentModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &attachment)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if attachment.Source.IsUnknown() || attachment.ContentBase64.IsUnknown() {
+		return
+	}
+	if attachment.Source.IsNull() == attachment.ContentBase64.IsNull() {
+		resp.Diagnostics.AddError("Invalid Attachment Content", "Exactly one of source or content_base64 must be set.")
+	}
+}
+
+// ModifyPlan computes the checksum of the configured content so that edits to the
+// local file or the base64 content replace the attachment.
+func (r *AccountAttachment) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	var plan AccountAttachmentModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.Source.IsUnknown() || plan.ContentBase64.IsUnknown() {
+		return
+	}
+	content, ok := attachment_content(plan, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	checksum := attachment_checksum(content)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("content_sha256"), checksum)...)
+	if req.State.Raw.IsNull() {
+		return
+	}
+	var state AccountAttachmentModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if state.ContentSHA256.ValueString() != checksum {
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("content_sha256"))
+	}
+}
+
+func (r *AccountAttachment) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*http.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+func (r *AccountAttachment) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !check_resource_configured(r.client, &resp.Diagnostics) {
+		return
+	}
+	var attachment AccountAttachmentModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &attachment)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	content, ok := attachment_content(attachment, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	id, code, message := upload_attachment_function(ctx, attachment.AccountID.ValueInt64(), attachment.Name.ValueString(), content)
+	if code != 200 {
+		resp.Diagnostics.AddError(fmt.Sprintf("%d - %s", code, message), "")
+		return
+	}
+	attachment.ID = types.Int64Value(id)
+	attachment.ContentSHA256 = types.StringValue(attachment_checksum(content))
+	attachment.Size = types.Int64Value(int64(len(content)))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &attachment)...)
+}
+
+func (r *AccountAttachment) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !check_resource_configured(r.client, &resp.Diagnostics) {
+		return
+	}
+	var attachment AccountAttachmentModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &attachment)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	attachments, code, message := get_attachments_function(ctx, attachment.AccountID.ValueInt64())
+	if code == 404 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if code != 200 {
+		resp.Diagnostics.AddError(fmt.Sprintf("%d - %s", code, message), "")
+		return
+	}
+	for _, data := range attachments {
+		if data.ID == attachment.ID.ValueInt64() {
+			attachment.Name = types.StringValue(data.Name)
+			attachment.Size = types.Int64Value(data.Size)
+			resp.Diagnostics.Append(resp.State.Set(ctx, &attachment)...)
+			return
+		}
+	}
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *AccountAttachment) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !check_resource_configured(r.client, &resp.Diagnostics) {
+		return
+	}
+	// Content changes replace the attachment; switching between source and
+	// content_base64 with identical content only updates state.
+	var attachment AccountAttachmentModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &attachment)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &attachment)...)
+}
+
+func (r *AccountAttachment) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !check_resource_configured(r.client, &resp.Diagnostics) {
+		return
+	}
+	var attachment AccountAttachmentModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &attachment)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	params := make(map[string]any)
+	setParam(params, "account_id", attachment.AccountID)
+	setParam(params, "attachment_id", attachment.ID)
+	code, message := delete_attachment_function(ctx, params)
+	if code != 200 && code != 404 {
+		resp.Diagnostics.AddError(fmt.Sprintf("%d - %s", code, message), "")
+	}
+}
+
+// attachment_content returns the bytes to upload, read from source or decoded
+// from content_base64.
+func attachment_content(attachment AccountAttachmentModel, diags *diag.Diagnostics) ([]byte, bool) {
+	if !attachment.Source.IsNull() {
+		content, err := os.ReadFile(attachment.Source.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("source"), "Unable to Read Attachment", err.Error())
+			return nil, false
+		}
+		return content, true
+	}
+	content, err := base64.StdEncoding.DecodeString(attachment.ContentBase64.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("content_base64"), "Invalid Attachment Content", fmt.Sprintf("content_base64 is not valid base64: %v", err))
+		return nil, false
+	}
+	return content, true
+}
+
+func attachment_checksum(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,180 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &AccountAudit{}
+var _ datasource.DataSourceWithValidateConfig = &AccountAudit{}
+
+func account_audit() datasource.DataSource {
+	return &AccountAudit{}
+}
+
+type AccountAudit struct {
+	client *http.Client
+}
+
+type AccountAuditModel struct {
+	AccountID types.Int64              `tfsdk:"account_id"`
+	FolderID  types.Int64              `tfsdk:"folder_id"`
+	From      types.String             `tfsdk:"from"`
+	To        types.String             `tfsdk:"to"`
+	Entries   []AccountAuditEntryModel `tfsdk:"entries"`
+}
+
+type AccountAuditEntryModel struct {
+	Timestamp types.String `tfsdk:"timestamp"`
+	AccountID types.Int64  `tfsdk:"account_id"`
+	User      types.String `tfsdk:"user"`
+	Operation types.String `tfsdk:"operation"`
+	SourceIP  types.String `tfsdk:"source_ip"`
+	Reason    types.String `tfsdk:"reason"`
+}
+
+func (d *AccountAudit) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_account_audit"
+}
+
+func (d *AccountAudit) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Retrieves the audit trail of a Securden account or of the accounts in a folder.",
+
+		Attributes: map[string]schema.Attribute{
+			"account_id": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Unique identifier of the account. Exactly one of `account_id` or `folder_id` must be set.",
+			},
+			"folder_id": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "The ID of the folder whose accounts are audited.",
+			},
+			"from": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return entries recorded at or after this RFC 3339 time, e.g. `2024-01-01T00:00:00Z`.",
+			},
+			"to": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return entries recorded before this RFC 3339 time.",
+			},
+			"entries": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The audit entries, in the order returned by the server.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"timestamp": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Time at which the operation was performed.",
+						},
+						"account_id": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Unique identifier of the account the operation was performed on.",
+						},
+						"user": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The user who performed the operation.",
+						},
+						"operation": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The operation, e.g. `Password Retrieved`.",
+						},
+						"source_ip": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "IP address the operation was performed from.",
+						},
+						"reason": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Reason given for the operation, if any.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *AccountAudit) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var audit AccountAuditModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &audit)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !audit.AccountID.IsUnknown() && !audit.FolderID.IsUnknown() && audit.AccountID.IsNull() == audit.FolderID.IsNull() {
+		resp.Diagnostics.AddError("Invalid Audit Selector", "Exactly one of account_id or folder_id must be set.")
+	}
+	var from, to time.Time
+	for name, value := range map[string]types.String{"from": audit.From, "to": audit.To} {
+		if value.IsNull() || value.IsUnknown() {
+			continue
+		}
+		parsed, err := time.Parse(time.RFC3339, value.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root(name), "Invalid Time", fmt.Sprintf("%s must be an RFC 3339 time, e.g. 2024-01-01T00:00:00Z: %v", name, err))
+			continue
+		}
+		if name == "from" {
+			from = parsed
+		} else {
+			to = parsed
+		}
+	}
+	if !from.IsZero() && !to.IsZero() && !from.Before(to) {
+		resp.Diagnostics.AddAttributeError(path.Root("to"), "Invalid Time Range", "to must be later than from.")
+	}
+}
+
+func (d *AccountAudit) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*http.Client)
+	if !ok {
+		resp.Diagnostics.AddWarning(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+func (d *AccountAudit) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if !check_configured(d.client, req, resp) {
+		return
+	}
+	var audit AccountAuditModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &audit)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	params := make(map[string]any)
+	setParam(params, "account_id", audit.AccountID)
+	setParam(params, "folder_id", audit.FolderID)
+	setParam(params, "from", audit.From)
+	setParam(params, "to", audit.To)
+	entries, code, message := get_audit_trail_function(ctx, params)
+	if code != 200 {
+		resp.Diagnostics.AddError(fmt.Sprintf("%d - %s", code, message), "")
+		return
+	}
+	audit.Entries = make([]AccountAuditEntryModel, 0, len(entries))
+	for _, entry := range entries {
+		audit.Entries = append(audit.Entries, AccountAuditEntryModel{
+			Timestamp: types.StringValue(entry.Timestamp),
+			AccountID: types.Int64Value(entry.AccountID),
+			User:      types.StringValue(entry.User),
+			Operation: types.StringValue(entry.Operation),
+			SourceIP:  types.StringValue(entry.SourceIP),
+			Reason:    types.StringValue(entry.Reason),
+		})
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &audit)...)
+}
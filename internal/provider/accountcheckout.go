@@ -0,0 +1,152 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ ephemeral.EphemeralResource = &AccountCheckout{}
+var _ ephemeral.EphemeralResourceWithConfigure = &AccountCheckout{}
+var _ ephemeral.EphemeralResourceWithClose = &AccountCheckout{}
+
+func account_checkout() ephemeral.EphemeralResource {
+	return &AccountCheckout{}
+}
+
+type AccountCheckout struct {
+	client *http.Client
+}
+
+type AccountCheckoutModel struct {
+	AccountID  types.Int64  `tfsdk:"account_id"`
+	Reason     types.String `tfsdk:"reason"`
+	Duration   types.Int64  `tfsdk:"duration"`
+	CheckoutID types.String `tfsdk:"checkout_id"`
+	Password   types.String `tfsdk:"password"`
+	ExpiresAt  types.String `tfsdk:"expires_at"`
+}
+
+// accountCheckoutPrivate is kept in the ephemeral resource private data so that
+// Close knows what to check in.
+type accountCheckoutPrivate struct {
+	AccountID  int64  `json:"account_id"`
+	CheckoutID string `json:"checkout_id"`
+}
+
+func (e *AccountCheckout) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_account_checkout"
+}
+
+func (e *AccountCheckout) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Checks out an exclusive-access Securden account for the duration of a Terraform run and checks it back in when the run no longer needs it.",
+
+		Attributes: map[string]schema.Attribute{
+			"account_id": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "Unique identifier of the account to check out.",
+			},
+			"reason": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Reason recorded in the Securden audit trail for the checkout.",
+			},
+			"duration": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: fmt.Sprintf("Minutes the account stays checked out if it is not checked in earlier. Defaults to %d.", default_checkout_duration),
+			},
+			"checkout_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "ID of the checkout.",
+			},
+			"password": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The password of the checked out account.",
+			},
+			"expires_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Time at which Securden checks the account in automatically.",
+			},
+		},
+	}
+}
+
+func (e *AccountCheckout) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*http.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	e.client = client
+}
+
+func (e *AccountCheckout) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	if !check_resource_configured(e.client, &resp.Diagnostics) {
+		return
+	}
+	var checkout AccountCheckoutModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &checkout)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	duration := default_checkout_duration
+	if !checkout.Duration.IsNull() {
+		duration = checkout.Duration.ValueInt64()
+	}
+	if duration < 1 {
+		resp.Diagnostics.AddError("Invalid Checkout Duration", "duration must be at least 1 minute.")
+		return
+	}
+	params := make(map[string]any)
+	setParam(params, "account_id", checkout.AccountID)
+	setParam(params, "reason", checkout.Reason)
+	params["duration"] = duration
+	data, code, message := checkout_account_function(ctx, params)
+	if code != 200 {
+		resp.Diagnostics.AddError(fmt.Sprintf("%d - %s", code, message), "")
+		return
+	}
+	private, err := json.Marshal(accountCheckoutPrivate{AccountID: checkout.AccountID.ValueInt64(), CheckoutID: data.CheckoutID})
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Save Checkout", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, "checkout", private)...)
+	checkout.CheckoutID = types.StringValue(data.CheckoutID)
+	checkout.Password = types.StringValue(data.Password)
+	checkout.ExpiresAt = types.StringValue(data.ExpiresAt)
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &checkout)...)
+}
+
+func (e *AccountCheckout) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	data, diags := req.Private.GetKey(ctx, "checkout")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || data == nil {
+		return
+	}
+	var private accountCheckoutPrivate
+	if err := json.Unmarshal(data, &private); err != nil {
+		resp.Diagnostics.AddError("Unable to Read Checkout", err.Error())
+		return
+	}
+	params := map[string]any{"account_id": private.AccountID}
+	if private.CheckoutID != "" {
+		params["checkout_id"] = private.CheckoutID
+	}
+	code, message := checkin_account_function(ctx, params)
+	if code != 200 {
+		resp.Diagnostics.AddError(fmt.Sprintf("%d - %s", code, message), "")
+	}
+}
@@ -0,0 +1,89 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ ephemeral.EphemeralResource = &AccountPassword{}
+var _ ephemeral.EphemeralResourceWithConfigure = &AccountPassword{}
+
+func account_password() ephemeral.EphemeralResource {
+	return &AccountPassword{}
+}
+
+type AccountPassword struct {
+	client *http.Client
+}
+
+type AccountPasswordModel struct {
+	AccountID types.Int64  `tfsdk:"account_id"`
+	Password  types.String `tfsdk:"password"`
+}
+
+func (e *AccountPassword) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_account_password"
+}
+
+func (e *AccountPassword) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads the password of a Securden account without storing it in plan or state.",
+
+		Attributes: map[string]schema.Attribute{
+			"account_id": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "Unique identifier of the account.",
+			},
+			"password": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The password of the account.",
+			},
+		},
+	}
+}
+
+func (e *AccountPassword) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*http.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	e.client = client
+}
+
+func (e *AccountPassword) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	if !check_resource_configured(e.client, &resp.Diagnostics) {
+		return
+	}
+	var account AccountPasswordModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &account)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	accountID := strconv.FormatInt(account.AccountID.ValueInt64(), 10)
+	passwords, code, message := get_passwords(ctx, []string{accountID})
+	if code != 200 {
+		resp.Diagnostics.AddError(fmt.Sprintf("%d - %s", code, message), "")
+		return
+	}
+	password, ok := passwords.Elements()[accountID].(types.String)
+	if !ok {
+		resp.Diagnostics.AddError("Password Not Found", fmt.Sprintf("Securden did not return a password for account %s.", accountID))
+		return
+	}
+	account.Password = password
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &account)...)
+}
@@ -0,0 +1,598 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &AccountResource{}
+var _ resource.ResourceWithImportState = &AccountResource{}
+var _ resource.ResourceWithValidateConfig = &AccountResource{}
+var _ resource.ResourceWithModifyPlan = &AccountResource{}
+
+func account_resource() resource.Resource {
+	return &AccountResource{}
+}
+
+type AccountResource struct {
+	client *http.Client
+}
+
+type AccountResourceModel struct {
+	ID                        types.Int64  `tfsdk:"id"`
+	AccountName               types.String `tfsdk:"account_name"`
+	AccountTitle              types.String `tfsdk:"account_title"`
+	AccountType               types.String `tfsdk:"account_type"`
+	IPAddress                 types.String `tfsdk:"ipaddress"`
+	Notes                     types.String `tfsdk:"notes"`
+	Tags                      types.Set    `tfsdk:"tags"`
+	TagsAll                   types.Set    `tfsdk:"tags_all"`
+	PersonalAccount           types.Bool   `tfsdk:"personal_account"`
+	FolderID                  types.Int64  `tfsdk:"folder_id"`
+	AccountExpirationDate     types.String `tfsdk:"account_expiration_date"`
+	DistinguishedName         types.String `tfsdk:"distinguished_name"`
+	AccountAlias              types.String `tfsdk:"account_alias"`
+	DomainName                types.String `tfsdk:"domain_name"`
+	PasswordPolicyID          types.Int64  `tfsdk:"password_policy_id"`
+	AdditionalFields          types.Map    `tfsdk:"additional_fields"`
+	SensitiveAdditionalFields types.Map    `tfsdk:"sensitive_additional_fields"`
+	OverwriteAdditionalFields types.Bool   `tfsdk:"overwrite_additional_fields"`
+	Reason                    types.String `tfsdk:"reason"`
+	PermanentDeleteOnDestroy  types.Bool   `tfsdk:"permanent_delete_on_destroy"`
+	DeletionProtection        types.Bool   `tfsdk:"deletion_protection"`
+	PasswordWO                types.String `tfsdk:"password_wo"`
+	PasswordWOVersion         types.Int64  `tfsdk:"password_wo_version"`
+	PrivateKeyWO              types.String `tfsdk:"private_key_wo"`
+	PrivateKeyPassphrase      types.String `tfsdk:"private_key_passphrase_wo"`
+	PrivateKeyWOVersion       types.Int64  `tfsdk:"private_key_wo_version"`
+}
+
+func (r *AccountResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_account"
+}
+
+func (r *AccountResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages an account in Securden.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Unique identifier of the account.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"account_title": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The title associated with the account.",
+			},
+			"account_name": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The name associated with the account.",
+			},
+			"account_type": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Specifies the type or category of the account. Changing it replaces the account.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ipaddress": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The IP address of the account (if applicable).",
+			},
+			"notes": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Additional notes related to the account.",
+			},
+			"tags": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Tags associated with the account.",
+			},
+			"tags_all": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Computed:            true,
+				MarkdownDescription: "All tags of the account, including the provider's `default_tags`.",
+			},
+			"personal_account": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Indicates whether the account is personal. Changing it replaces the account.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"folder_id": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "The ID of the folder where the account is stored.",
+			},
+			"account_expiration_date": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The expiration date of the account (format: DD/MM/YYYY).",
+			},
+			"distinguished_name": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Required for LDAP domain accounts.",
+			},
+			"account_alias": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Required for AWS IAM accounts.",
+			},
+			"domain_name": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Required for Google Workspace accounts.",
+			},
+			"password_policy_id": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "The ID of the password policy attached to the account. `password_wo` is validated against it before it is sent.",
+			},
+			"additional_fields": schema.MapAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Additional (custom) fields of the account, keyed by field name.",
+			},
+			"sensitive_additional_fields": schema.MapAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				Sensitive:           true,
+				MarkdownDescription: "Additional fields holding secrets, e.g. API keys. Managed like `additional_fields` but masked in plan output. A field name must not appear in both maps.",
+			},
+			"overwrite_additional_fields": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "When `true`, the configured maps are the complete set of additional fields: fields added outside Terraform show up as drift and are removed on apply. When `false` (the default), only the configured fields are managed and other fields are left untouched.",
+			},
+			"reason": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Reason recorded in the Securden audit trail for the changes Terraform makes to the account. Overrides the provider's `default_reason`.",
+			},
+			"deletion_protection": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "When `true`, destroying or replacing the account fails. Set it to `false` in a prior apply to allow deletion.",
+			},
+			"permanent_delete_on_destroy": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "When `true`, destroying the resource deletes the account permanently. By default the account is moved to the recycle bin, from where it can be restored with `securden_restore_accounts`.",
+			},
+			"password_wo": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+				MarkdownDescription: "The password of the account. Write-only: it is never stored in plan or state, so it is only sent on create and when `password_wo_version` changes.",
+			},
+			"password_wo_version": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Version of `password_wo`. Increment it to send a new password to Securden.",
+			},
+			"private_key_wo": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+				MarkdownDescription: "The SSH private key (PEM or OpenSSH format) of an SSH key account. Write-only: it is only sent on create and when `private_key_wo_version` changes.",
+			},
+			"private_key_passphrase_wo": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+				MarkdownDescription: "The passphrase protecting `private_key_wo`, if any. Write-only.",
+			},
+			"private_key_wo_version": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Version of `private_key_wo`. Increment it to upload a new private key to Securden.",
+			},
+		},
+	}
+}
+
+func (r *AccountResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var account AccountResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &account)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !account.PasswordWOVersion.IsNull() && account.PasswordWO.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("password_wo"), "Missing Password", "password_wo must be set when password_wo_version is set.")
+	}
+	// Keys are known whenever the maps are, even if some values are not.
+	for key := range account.SensitiveAdditionalFields.Elements() {
+		if _, ok := account.AdditionalFields.Elements()[key]; ok {
+			resp.Diagnostics.AddAttributeError(path.Root("sensitive_additional_fields"), "Duplicate Additional Field", fmt.Sprintf("Field %q is set in both additional_fields and sensitive_additional_fields.", key))
+		}
+	}
+	if !account.PrivateKeyWOVersion.IsNull() && account.PrivateKeyWO.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("private_key_wo"), "Missing Private Key", "private_key_wo must be set when private_key_wo_version is set.")
+	}
+	if !account.PrivateKeyPassphrase.IsNull() && account.PrivateKeyWO.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("private_key_passphrase_wo"), "Missing Private Key", "private_key_passphrase_wo requires private_key_wo.")
+	}
+}
+
+// ModifyPlan fills in tags_all so that changes to default_tags show up in the plan,
+// and checks that the fields required by account_type are set.
+func (r *AccountResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	r.validate_account_type(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var tags types.Set
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("tags"), &tags)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tagsAll := types.SetUnknown(types.StringType)
+	if tag_set_known(tags) {
+		tagsAll = string_set_value(normalize_tags(with_default_tags(tag_set_values(tags))))
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("tags_all"), tagsAll)...)
+}
+
+// validate_account_type runs only when the provider is configured, as the account
+// type catalog is read from the server.
+func (r *AccountResource) validate_account_type(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client == nil {
+		return
+	}
+	var accountType types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("account_type"), &accountType)...)
+	if resp.Diagnostics.HasError() || accountType.IsUnknown() || accountType.IsNull() {
+		return
+	}
+	fields := make(map[string]types.String)
+	for _, field := range []string{"distinguished_name", "account_alias", "domain_name"} {
+		var value types.String
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root(field), &value)...)
+		fields[field] = value
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	validate_account_type(ctx, accountType.ValueString(), fields, &resp.Diagnostics)
+}
+
+func (r *AccountResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*http.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+func (r *AccountResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !check_resource_configured(r.client, &resp.Diagnostics) {
+		return
+	}
+	var account AccountResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &account)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// Write-only values are only available in the configuration.
+	var config AccountResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !check_password_policy(ctx, account.PasswordPolicyID, config.PasswordWO, &resp.Diagnostics) {
+		return
+	}
+	params := account_resource_params(account)
+	setParam(params, "personal_account", account.PersonalAccount)
+	setParam(params, "password", config.PasswordWO)
+	setParam(params, "private_key", config.PrivateKeyWO)
+	setParam(params, "private_key_passphrase", config.PrivateKeyPassphrase)
+	if fields := additional_fields_param(ctx, account.AdditionalFields, account.SensitiveAdditionalFields, &resp.Diagnostics); fields != nil {
+		params["additional_fields"] = fields
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	added_account, code, message := add_account_function(ctx, params)
+	if code != 200 && code != 0 {
+		resp.Diagnostics.AddError(fmt.Sprintf("%d - %s", code, message), "")
+		return
+	}
+	account.ID = added_account.ID
+	account.TagsAll = account_tags_all(account)
+	clear_write_only(&account)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &account)...)
+}
+
+func (r *AccountResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !check_resource_configured(r.client, &resp.Diagnostics) {
+		return
+	}
+	var account AccountResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &account)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !read_account_resource(ctx, &account, &resp.Diagnostics) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &account)...)
+}
+
+func (r *AccountResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !check_resource_configured(r.client, &resp.Diagnostics) {
+		return
+	}
+	var account AccountResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &account)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var state AccountResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	changed := account_changed(ctx, req.Plan, req.State, state, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !changed {
+		account.TagsAll = account_tags_all(account)
+		clear_write_only(&account)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &account)...)
+		return
+	}
+	var config AccountResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	params := account_resource_params(account)
+	setParam(params, "account_id", account.ID)
+	params["additional_fields"] = changed_additional_fields(ctx, state, account, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	setParam(params, "overwrite_additional_fields", account.OverwriteAdditionalFields)
+	if !account.PasswordWOVersion.Equal(state.PasswordWOVersion) {
+		if !check_password_policy(ctx, account.PasswordPolicyID, config.PasswordWO, &resp.Diagnostics) {
+			return
+		}
+		setParam(params, "password", config.PasswordWO)
+	}
+	if !account.PrivateKeyWOVersion.Equal(state.PrivateKeyWOVersion) {
+		setParam(params, "private_key", config.PrivateKeyWO)
+		setParam(params, "private_key_passphrase", config.PrivateKeyPassphrase)
+	}
+	_, code, message := edit_account_function(ctx, params)
+	if code != 200 && code != 0 {
+		resp.Diagnostics.AddError(fmt.Sprintf("%d - %s", code, message), "")
+		return
+	}
+	account.TagsAll = account_tags_all(account)
+	clear_write_only(&account)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &account)...)
+}
+
+func (r *AccountResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !check_resource_configured(r.client, &resp.Diagnostics) {
+		return
+	}
+	var account AccountResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &account)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !check_deletion_protection("account", account.ID, account.DeletionProtection, &resp.Diagnostics) {
+		return
+	}
+	params := make(map[string]any)
+	params["account_ids"] = []int64{account.ID.ValueInt64()}
+	params["delete_permanently"] = account.PermanentDeleteOnDestroy.ValueBool()
+	setParam(params, "reason", account.Reason)
+	_, code, message := delete_accounts_function(ctx, params)
+	if code != 200 && code != 404 {
+		resp.Diagnostics.AddError(fmt.Sprintf("%d - %s", code, message), "")
+	}
+}
+
+func (r *AccountResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("Expected a numeric account ID, got: %q", req.ID))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("overwrite_additional_fields"), false)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("permanent_delete_on_destroy"), false)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("deletion_protection"), false)...)
+}
+
+// account_changed reports whether the plan changes the account in Securden.
+// reason, permanent_delete_on_destroy and deletion_protection are only used by
+// the provider, so changing them alone does not edit the account.
+func account_changed(ctx context.Context, plan tfsdk.Plan, rawState tfsdk.State, state AccountResourceModel, diags *diag.Diagnostics) bool {
+	compared := tfsdk.Plan{Schema: plan.Schema, Raw: plan.Raw.Copy()}
+	diags.Append(compared.SetAttribute(ctx, path.Root("reason"), state.Reason)...)
+	diags.Append(compared.SetAttribute(ctx, path.Root("permanent_delete_on_destroy"), state.PermanentDeleteOnDestroy)...)
+	diags.Append(compared.SetAttribute(ctx, path.Root("deletion_protection"), state.DeletionProtection)...)
+	return !compared.Raw.Equal(rawState.Raw)
+}
+
+// clear_write_only nulls the write-only attributes, which must never be saved in
+// state.
+func clear_write_only(account *AccountResourceModel) {
+	account.PasswordWO = types.StringNull()
+	account.PrivateKeyWO = types.StringNull()
+	account.PrivateKeyPassphrase = types.StringNull()
+}
+
+// account_resource_params builds the request body shared by create and update.
+// The password is added by the caller because it is only sent when it changes.
+func account_resource_params(account AccountResourceModel) map[string]any {
+	params := make(map[string]any)
+	setParam(params, "account_name", account.AccountName)
+	setParam(params, "account_title", account.AccountTitle)
+	setParam(params, "account_type", account.AccountType)
+	setParam(params, "ipaddress", account.IPAddress)
+	setParam(params, "notes", account.Notes)
+	params["tags"] = tags_param(with_default_tags(tag_set_values(account.Tags)))
+	setParam(params, "folder_id", account.FolderID)
+	setParam(params, "account_expiration_date", account.AccountExpirationDate)
+	setParam(params, "distinguished_name", account.DistinguishedName)
+	setParam(params, "account_alias", account.AccountAlias)
+	setParam(params, "domain_name", account.DomainName)
+	setParam(params, "password_policy_id", account.PasswordPolicyID)
+	setParam(params, "reason", account.Reason)
+	return params
+}
+
+// read_account_resource refreshes the attributes reported by
+// /secretsmanagement/get_account. Attributes the server does not return keep
+// their state value. It returns false when the account no longer exists.
+func read_account_resource(ctx context.Context, account *AccountResourceModel, diags *diag.Diagnostics) bool {
+	data, code, message := get_account(ctx, account.ID.ValueInt64(), "", "", "", account.Reason.ValueString())
+	if code == 404 {
+		return false
+	}
+	if code != 200 {
+		diags.AddError(fmt.Sprintf("%d - %s", code, message), "")
+		return true
+	}
+	fields := data.Account.Elements()
+	for key, target := range map[string]*types.String{
+		"account_name":            &account.AccountName,
+		"account_title":           &account.AccountTitle,
+		"account_type":            &account.AccountType,
+		"ipaddress":               &account.IPAddress,
+		"notes":                   &account.Notes,
+		"account_expiration_date": &account.AccountExpirationDate,
+		"distinguished_name":      &account.DistinguishedName,
+		"account_alias":           &account.AccountAlias,
+		"domain_name":             &account.DomainName,
+	} {
+		value, ok := fields[key].(types.String)
+		if !ok || (value.ValueString() == "" && target.IsNull()) {
+			continue
+		}
+		*target = value
+	}
+	if value, ok := fields["tags"].(types.String); ok {
+		refresh_tags(account, parse_tags(value.ValueString()))
+	}
+	additionalFields := make(map[string]string)
+	if value, ok := fields["additional_fields"].(types.Map); ok {
+		for key, element := range value.Elements() {
+			if field, ok := element.(types.String); ok {
+				additionalFields[key] = field.ValueString()
+			}
+		}
+	}
+	refresh_additional_fields(account, additionalFields)
+	if value, ok := fields["folder_id"].(types.String); ok && value.ValueString() != "" {
+		if folderID, err := strconv.ParseInt(value.ValueString(), 10, 64); err == nil && (folderID != 0 || !account.FolderID.IsNull()) {
+			account.FolderID = types.Int64Value(folderID)
+		}
+	}
+	return true
+}
+
+// changed_additional_fields builds the additional_fields parameter of an update.
+// With overwrite_additional_fields the complete set is sent; otherwise fields
+// removed from the configuration are sent with an empty value to clear them,
+// leaving fields managed outside Terraform untouched.
+func changed_additional_fields(ctx context.Context, state, plan AccountResourceModel, diags *diag.Diagnostics) map[string]string {
+	fields := additional_fields_param(ctx, plan.AdditionalFields, plan.SensitiveAdditionalFields, diags)
+	if fields == nil {
+		fields = map[string]string{}
+	}
+	if plan.OverwriteAdditionalFields.ValueBool() {
+		return fields
+	}
+	for _, previous := range []types.Map{state.AdditionalFields, state.SensitiveAdditionalFields} {
+		for key := range previous.Elements() {
+			if _, ok := fields[key]; !ok {
+				fields[key] = ""
+			}
+		}
+	}
+	return fields
+}
+
+// refresh_additional_fields splits the fields reported by the server between the
+// plain and sensitive maps. Unmanaged fields are only tracked, as plain fields,
+// with overwrite_additional_fields so that they show up as drift.
+func refresh_additional_fields(account *AccountResourceModel, fields map[string]string) {
+	var plain, sensitive map[string]string
+	for key, value := range fields {
+		if _, ok := account.SensitiveAdditionalFields.Elements()[key]; ok {
+			if sensitive == nil {
+				sensitive = map[string]string{}
+			}
+			sensitive[key] = value
+			continue
+		}
+		if _, ok := account.AdditionalFields.Elements()[key]; ok || account.OverwriteAdditionalFields.ValueBool() {
+			if plain == nil {
+				plain = map[string]string{}
+			}
+			plain[key] = value
+		}
+	}
+	if plain == nil && !account.AdditionalFields.IsNull() {
+		plain = map[string]string{}
+	}
+	if sensitive == nil && !account.SensitiveAdditionalFields.IsNull() {
+		sensitive = map[string]string{}
+	}
+	account.AdditionalFields = string_map_value(plain)
+	account.SensitiveAdditionalFields = string_map_value(sensitive)
+}
+
+func account_tags_all(account AccountResourceModel) types.Set {
+	return string_set_value(normalize_tags(with_default_tags(tag_set_values(account.Tags))))
+}
+
+// refresh_tags records the server tags in tags_all and keeps in tags the ones
+// that are configured or not added by default_tags.
+func refresh_tags(account *AccountResourceModel, serverTags []string) {
+	configured := tag_set_values(account.Tags)
+	tagsAll := normalize_tags(serverTags)
+	var tags []string
+	for _, tag := range tagsAll {
+		if slices.Contains(configured, tag) || !slices.Contains(SecurdenDefaultTags, tag) {
+			tags = append(tags, tag)
+		}
+	}
+	if tags == nil && !account.Tags.IsNull() {
+		tags = []string{}
+	}
+	account.TagsAll = string_set_value(tagsAll)
+	account.Tags = string_set_value(tags)
+}
@@ -4,9 +4,11 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -21,8 +23,31 @@ type Accounts struct {
 }
 
 type AccountsModel struct {
-	AccountIDs []types.Int64                `tfsdk:"account_ids"`
-	Accounts   map[string]map[string]string `tfsdk:"accounts"`
+	AccountIDs   []types.Int64                  `tfsdk:"account_ids"`
+	FolderID     types.Int64                    `tfsdk:"folder_id"`
+	AccountType  types.String                   `tfsdk:"account_type"`
+	Tag          types.String                   `tfsdk:"tag"`
+	NamePattern  types.String                   `tfsdk:"name_pattern"`
+	TitlePattern types.String                   `tfsdk:"title_pattern"`
+	IPAddress    types.String                   `tfsdk:"ipaddress"`
+	Owner        types.String                   `tfsdk:"owner"`
+	Limit        types.Int64                    `tfsdk:"limit"`
+	PageSize     types.Int64                    `tfsdk:"page_size"`
+	Concurrency  types.Int64                    `tfsdk:"concurrency"`
+	Accounts     map[string]map[string]string   `tfsdk:"accounts"`
+	AccountList  []AccountSummaryModel          `tfsdk:"account_list"`
+	AccountMap   map[string]AccountSummaryModel `tfsdk:"account_map"`
+}
+
+type AccountSummaryModel struct {
+	ID           types.Int64  `tfsdk:"id"`
+	AccountName  types.String `tfsdk:"account_name"`
+	AccountTitle types.String `tfsdk:"account_title"`
+	AccountType  types.String `tfsdk:"account_type"`
+	IPAddress    types.String `tfsdk:"ipaddress"`
+	FolderID     types.Int64  `tfsdk:"folder_id"`
+	Owner        types.String `tfsdk:"owner"`
+	Tags         types.Set    `tfsdk:"tags"`
 }
 
 func (d *Accounts) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -31,19 +56,110 @@ func (d *Accounts) Metadata(ctx context.Context, req datasource.MetadataRequest,
 
 func (d *Accounts) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Retrieves multiple account details from Securden.",
+		MarkdownDescription: "Retrieves multiple account details from Securden, either by ID or by filters.",
 
 		Attributes: map[string]schema.Attribute{
 			"account_ids": schema.ListAttribute{
 				ElementType:         types.Int64Type,
-				MarkdownDescription: "A list of account IDs to fetch details for.",
-				Required:            true,
+				MarkdownDescription: "A list of account IDs to fetch details for. When omitted, accounts are discovered using the filters.",
+				Optional:            true,
+			},
+			"folder_id": schema.Int64Attribute{
+				MarkdownDescription: "Only return accounts stored in this folder.",
+				Optional:            true,
+			},
+			"account_type": schema.StringAttribute{
+				MarkdownDescription: "Only return accounts of this type.",
+				Optional:            true,
+			},
+			"tag": schema.StringAttribute{
+				MarkdownDescription: "Only return accounts carrying this tag.",
+				Optional:            true,
+			},
+			"name_pattern": schema.StringAttribute{
+				MarkdownDescription: "Only return accounts whose name matches this pattern. Supports `*` and `?` wildcards, case-insensitive.",
+				Optional:            true,
+			},
+			"title_pattern": schema.StringAttribute{
+				MarkdownDescription: "Only return accounts whose title matches this pattern. Supports `*` and `?` wildcards, case-insensitive.",
+				Optional:            true,
+			},
+			"ipaddress": schema.StringAttribute{
+				MarkdownDescription: "Only return accounts with this IP address.",
+				Optional:            true,
+			},
+			"owner": schema.StringAttribute{
+				MarkdownDescription: "Only return accounts owned by this user.",
+				Optional:            true,
+			},
+			"limit": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("Maximum number of accounts to return. A warning is raised when more accounts match. Defaults to %d.", default_accounts_limit),
+				Optional:            true,
+			},
+			"page_size": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("Number of accounts requested per page when discovering accounts by filters. Defaults to %d.", account_page_size),
+				Optional:            true,
+			},
+			"concurrency": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("Maximum number of pages requested in parallel when discovering accounts by filters. Defaults to %d.", account_page_concurrency),
+				Optional:            true,
 			},
 			"accounts": schema.MapAttribute{
 				ElementType:         types.MapType{ElemType: types.StringType},
 				Computed:            true,
 				MarkdownDescription: "A map containing multiple account details, where each key represents an account ID and the value is a map of account attributes.",
 			},
+			"account_list": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The matching accounts as typed objects. Accounts are returned page by page and sorted numerically by ID within each page.",
+				NestedObject:        account_summary_object(),
+			},
+			"account_map": schema.MapNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The matching accounts as typed objects, keyed by account ID, for use with `for_each`.",
+				NestedObject:        account_summary_object(),
+			},
+		},
+	}
+}
+
+// account_summary_object is the nested object of account_list and account_map.
+func account_summary_object() schema.NestedAttributeObject {
+	return schema.NestedAttributeObject{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Unique identifier of the account.",
+			},
+			"account_name": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The name associated with the account.",
+			},
+			"account_title": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The title associated with the account.",
+			},
+			"account_type": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Specifies the type or category of the account.",
+			},
+			"ipaddress": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The IP address of the account (if applicable).",
+			},
+			"folder_id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the folder where the account is stored.",
+			},
+			"owner": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The owner of the account.",
+			},
+			"tags": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Computed:            true,
+				MarkdownDescription: "Tags associated with the account.",
+			},
 		},
 	}
 }
@@ -64,35 +180,166 @@ func (d *Accounts) Configure(ctx context.Context, req datasource.ConfigureReques
 }
 
 func (d *Accounts) Create(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if !check_configured(d.client, req, resp) {
+		return
+	}
 	var accounts AccountsModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &accounts)...)
-
-	params := make(map[string]any)
-	params["account_ids"] = accounts.AccountIDs
-
-	accountsData, code, message := get_accounts(ctx, params)
-	if code != 200 {
-		resp.Diagnostics.AddWarning(fmt.Sprintf("%d - %s", code, message), "")
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !read_accounts(ctx, &accounts, &resp.Diagnostics) {
 		return
 	}
-	accounts.Accounts = accountsData
-
 	resp.Diagnostics.Append(resp.State.Set(ctx, &accounts)...)
 }
 
 func (d *Accounts) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if !check_configured(d.client, req, resp) {
+		return
+	}
 	var accounts AccountsModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &accounts)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !read_accounts(ctx, &accounts, &resp.Diagnostics) {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &accounts)...)
+}
+
+// read_accounts fetches the accounts selected by the model's IDs and filters and
+// fills in the computed attributes. It returns false when the state should not be set.
+func read_accounts(ctx context.Context, accounts *AccountsModel, diags *diag.Diagnostics) bool {
+	namePattern, err := glob_to_regexp(accounts.NamePattern.ValueString())
+	if err != nil {
+		diags.AddError("Invalid Name Pattern", err.Error())
+		return false
+	}
+	titlePattern, err := glob_to_regexp(accounts.TitlePattern.ValueString())
+	if err != nil {
+		diags.AddError("Invalid Title Pattern", err.Error())
+		return false
+	}
+	match := func(account map[string]string) bool {
+		if !accounts.NamePattern.IsNull() && !namePattern.MatchString(account["account_name"]) {
+			return false
+		}
+		if !accounts.TitlePattern.IsNull() && !titlePattern.MatchString(account["account_title"]) {
+			return false
+		}
+		return true
+	}
+
+	limit := default_accounts_limit
+	if !accounts.Limit.IsNull() {
+		limit = accounts.Limit.ValueInt64()
+	}
+	if limit < 1 {
+		diags.AddError("Invalid Limit", "limit must be at least 1.")
+		return false
+	}
+
+	pageSize := int64(account_page_size)
+	if !accounts.PageSize.IsNull() {
+		pageSize = accounts.PageSize.ValueInt64()
+	}
+	if pageSize < 1 || pageSize > max_account_page_size {
+		diags.AddError("Invalid Page Size", fmt.Sprintf("page_size must be between 1 and %d.", max_account_page_size))
+		return false
+	}
+	concurrency := int64(account_page_concurrency)
+	if !accounts.Concurrency.IsNull() {
+		concurrency = accounts.Concurrency.ValueInt64()
+	}
+	if concurrency < 1 {
+		diags.AddError("Invalid Concurrency", "concurrency must be at least 1.")
+		return false
+	}
 
 	params := make(map[string]any)
-	params["account_ids"] = accounts.AccountIDs
+	setParam(params, "folder_id", accounts.FolderID)
+	setParam(params, "account_type", accounts.AccountType)
+	setParam(params, "tag", accounts.Tag)
+	setParam(params, "ipaddress", accounts.IPAddress)
+	setParam(params, "owner", accounts.Owner)
 
-	accountsData, code, message := get_accounts(ctx, params)
-	if code != 200 {
-		resp.Diagnostics.AddWarning(fmt.Sprintf("%d - %s", code, message), "")
-		return
+	var ids []string
+	var accountsData map[string]map[string]string
+	var truncated bool
+	if len(accounts.AccountIDs) > 0 {
+		params["account_ids"] = accounts.AccountIDs
+		data, code, message := get_accounts(ctx, params)
+		if code != 200 {
+			diags.AddWarning(fmt.Sprintf("%d - %s", code, message), "")
+			return false
+		}
+		accountsData = make(map[string]map[string]string)
+		for _, id := range sorted_account_ids(data) {
+			if !match(data[id]) {
+				continue
+			}
+			if int64(len(ids)) == limit {
+				truncated = true
+				break
+			}
+			ids = append(ids, id)
+			accountsData[id] = data[id]
+		}
+	} else {
+		var code int
+		var message string
+		ids, accountsData, truncated, code, message = search_accounts(ctx, params, int(limit), int(pageSize), int(concurrency), match)
+		if code != 200 {
+			diags.AddWarning(fmt.Sprintf("%d - %s", code, message), "")
+			return false
+		}
 	}
+	if truncated {
+		diags.AddWarning(
+			"Account Limit Reached",
+			fmt.Sprintf("More than %d accounts matched the filters; only the first %d are returned. Narrow the filters or raise limit.", limit, limit),
+		)
+	}
+
 	accounts.Accounts = accountsData
+	accounts.AccountList = make([]AccountSummaryModel, 0, len(ids))
+	accounts.AccountMap = make(map[string]AccountSummaryModel, len(ids))
+	for _, id := range ids {
+		summary := account_summary(id, accountsData[id])
+		accounts.AccountList = append(accounts.AccountList, summary)
+		accounts.AccountMap[id] = summary
+	}
+	return true
+}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, &accounts)...)
+func account_summary(id string, account map[string]string) AccountSummaryModel {
+	summary := AccountSummaryModel{
+		ID:           types.Int64Null(),
+		AccountName:  optional_string(account["account_name"]),
+		AccountTitle: optional_string(account["account_title"]),
+		AccountType:  optional_string(account["account_type"]),
+		IPAddress:    optional_string(account["ipaddress"]),
+		FolderID:     types.Int64Null(),
+		Owner:        optional_string(account["owner"]),
+		Tags:         types.SetNull(types.StringType),
+	}
+	if value, err := strconv.ParseInt(id, 10, 64); err == nil {
+		summary.ID = types.Int64Value(value)
+	}
+	if value, err := strconv.ParseInt(account["folder_id"], 10, 64); err == nil {
+		summary.FolderID = types.Int64Value(value)
+	}
+	if tags := parse_tags(account["tags"]); tags != nil {
+		summary.Tags = string_set_value(normalize_tags(tags))
+	}
+	return summary
+}
+
+func optional_string(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
 }
@@ -0,0 +1,105 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &AccountTypes{}
+
+func account_types_data_source() datasource.DataSource {
+	return &AccountTypes{}
+}
+
+type AccountTypes struct {
+	client *http.Client
+}
+
+type AccountTypesModel struct {
+	Source types.String       `tfsdk:"source"`
+	Types  []AccountTypeModel `tfsdk:"types"`
+}
+
+type AccountTypeModel struct {
+	Name           types.String   `tfsdk:"name"`
+	RequiredFields []types.String `tfsdk:"required_fields"`
+}
+
+func (d *AccountTypes) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_account_types"
+}
+
+func (d *AccountTypes) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the account types supported by the Securden server and the extra fields each of them requires.",
+
+		Attributes: map[string]schema.Attribute{
+			"source": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Where `types` comes from: `server` for the catalog of the Securden server, or `builtin` when the server does not publish one. The built-in list only holds the account types known to require extra fields and is not a complete list of supported types.",
+			},
+			"types": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The account types, sorted by name.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Name of the account type, as used in `account_type`.",
+						},
+						"required_fields": schema.ListAttribute{
+							ElementType:         types.StringType,
+							Computed:            true,
+							MarkdownDescription: "Fields that must be set when creating an account of this type, for example `distinguished_name` for LDAP accounts.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *AccountTypes) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*http.Client)
+	if !ok {
+		resp.Diagnostics.AddWarning(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+func (d *AccountTypes) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if !check_configured(d.client, req, resp) {
+		return
+	}
+	catalog, builtin, code, message := account_types(ctx)
+	if code != 200 {
+		resp.Diagnostics.AddError(fmt.Sprintf("%d - %s", code, message), "")
+		return
+	}
+	var state AccountTypesModel
+	state.Source = types.StringValue("server")
+	if builtin {
+		state.Source = types.StringValue("builtin")
+	}
+	state.Types = make([]AccountTypeModel, 0, len(catalog))
+	for _, accountType := range catalog {
+		fields := make([]types.String, 0, len(accountType.RequiredFields))
+		for _, field := range accountType.RequiredFields {
+			fields = append(fields, types.StringValue(field))
+		}
+		state.Types = append(state.Types, AccountTypeModel{Name: types.StringValue(accountType.Name), RequiredFields: fields})
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -21,21 +21,28 @@ type AddAccount struct {
 }
 
 type AddAccountModel struct {
-	AccountName           types.String `tfsdk:"account_name"`
-	AccountTitle          types.String `tfsdk:"account_title"`
-	AccountType           types.String `tfsdk:"account_type"`
-	IPAddress             types.String `tfsdk:"ipaddress"`
-	Notes                 types.String `tfsdk:"notes"`
-	Tags                  types.String `tfsdk:"tags"`
-	PersonalAccount       types.Bool   `tfsdk:"personal_account"`
-	FolderID              types.Int64  `tfsdk:"folder_id"`
-	Password              types.String `tfsdk:"password"`
-	AccountExpirationDate types.String `tfsdk:"account_expiration_date"`
-	DistinguishedName     types.String `tfsdk:"distinguished_name"`
-	AccountAlias          types.String `tfsdk:"account_alias"`
-	DomainName            types.String `tfsdk:"domain_name"`
-	Message               types.String `tfsdk:"message"`
-	ID                    types.Int64  `tfsdk:"id"`
+	AccountName               types.String   `tfsdk:"account_name"`
+	AccountTitle              types.String   `tfsdk:"account_title"`
+	AccountType               types.String   `tfsdk:"account_type"`
+	IPAddress                 types.String   `tfsdk:"ipaddress"`
+	Notes                     types.String   `tfsdk:"notes"`
+	Tags                      []types.String `tfsdk:"tags"`
+	PersonalAccount           types.Bool     `tfsdk:"personal_account"`
+	FolderID                  types.Int64    `tfsdk:"folder_id"`
+	Password                  types.String   `tfsdk:"password"`
+	AccountExpirationDate     types.String   `tfsdk:"account_expiration_date"`
+	DistinguishedName         types.String   `tfsdk:"distinguished_name"`
+	AccountAlias              types.String   `tfsdk:"account_alias"`
+	DomainName                types.String   `tfsdk:"domain_name"`
+	PasswordPolicyID          types.Int64    `tfsdk:"password_policy_id"`
+	Reason                    types.String   `tfsdk:"reason"`
+	AdditionalFields          types.Map      `tfsdk:"additional_fields"`
+	SensitiveAdditionalFields types.Map      `tfsdk:"sensitive_additional_fields"`
+	GeneratePassword          types.Bool     `tfsdk:"generate_password"`
+	PrivateKey                types.String   `tfsdk:"private_key"`
+	PrivateKeyPassphrase      types.String   `tfsdk:"private_key_passphrase"`
+	Message                   types.String   `tfsdk:"message"`
+	ID                        types.Int64    `tfsdk:"id"`
 }
 
 func (d *AddAccount) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -60,7 +67,7 @@ func (d *AddAccount) Schema(ctx context.Context, req datasource.SchemaRequest, r
 				Required:            true,
 			},
 			"password": schema.StringAttribute{
-				MarkdownDescription: "The password associated with the account.",
+				MarkdownDescription: "The password associated with the account. It is stored in state; use the `password_wo` argument of the `securden_account` resource to keep it out of state.",
 				Optional:            true,
 			},
 			"personal_account": schema.BoolAttribute{
@@ -79,8 +86,9 @@ func (d *AddAccount) Schema(ctx context.Context, req datasource.SchemaRequest, r
 				MarkdownDescription: "Additional notes related to the account.",
 				Optional:            true,
 			},
-			"tags": schema.StringAttribute{
-				MarkdownDescription: "Tags associated with the account.",
+			"tags": schema.SetAttribute{
+				MarkdownDescription: "Tags associated with the account. The provider's `default_tags` are added to them.",
+				ElementType:         types.StringType,
 				Optional:            true,
 			},
 			"account_expiration_date": schema.StringAttribute{
@@ -99,6 +107,39 @@ func (d *AddAccount) Schema(ctx context.Context, req datasource.SchemaRequest, r
 				MarkdownDescription: "Required for Google Workspace accounts.",
 				Optional:            true,
 			},
+			"additional_fields": schema.MapAttribute{
+				MarkdownDescription: "Additional (custom) fields of the account, keyed by field name.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"sensitive_additional_fields": schema.MapAttribute{
+				MarkdownDescription: "Additional fields holding secrets, e.g. API keys. Sent like `additional_fields` but masked in plan output.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"reason": schema.StringAttribute{
+				MarkdownDescription: "Reason recorded in the Securden audit trail. Overrides the provider's `default_reason`.",
+				Optional:            true,
+			},
+			"password_policy_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the password policy attached to the account. A supplied `password` is validated against it before the account is created.",
+				Optional:            true,
+			},
+			"generate_password": schema.BoolAttribute{
+				MarkdownDescription: "When `password` is omitted, generate a password that satisfies `password_policy_id` (or the server default policy). The generated password is never stored in state; read it with the `securden_account_password` ephemeral resource.",
+				Optional:            true,
+			},
+			"private_key": schema.StringAttribute{
+				MarkdownDescription: "The SSH private key (PEM or OpenSSH format) to store in an SSH key account.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"private_key_passphrase": schema.StringAttribute{
+				MarkdownDescription: "The passphrase protecting `private_key`, if any.",
+				Optional:            true,
+				Sensitive:           true,
+			},
 			"id": schema.Int64Attribute{
 				Computed:            true,
 				MarkdownDescription: "Unique identifier of the created account in Securden.",
@@ -127,6 +168,9 @@ func (d *AddAccount) Configure(ctx context.Context, req datasource.ConfigureRequ
 }
 
 func (d *AddAccount) Create(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if !check_configured(d.client, req, resp) {
+		return
+	}
 	var account AddAccountModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &account)...)
 	params := make(map[string]any)
@@ -135,7 +179,9 @@ func (d *AddAccount) Create(ctx context.Context, req datasource.ReadRequest, res
 	setParam(params, "account_type", account.AccountType)
 	setParam(params, "ipaddress", account.IPAddress)
 	setParam(params, "notes", account.Notes)
-	setParam(params, "tags", account.Tags)
+	if tags := tags_param(with_default_tags(tag_values(account.Tags))); tags != "" {
+		params["tags"] = tags
+	}
 	setParam(params, "personal_account", account.PersonalAccount)
 	setParam(params, "folder_id", account.FolderID)
 	setParam(params, "password", account.Password)
@@ -143,6 +189,36 @@ func (d *AddAccount) Create(ctx context.Context, req datasource.ReadRequest, res
 	setParam(params, "distinguished_name", account.DistinguishedName)
 	setParam(params, "account_alias", account.AccountAlias)
 	setParam(params, "domain_name", account.DomainName)
+	setParam(params, "password_policy_id", account.PasswordPolicyID)
+	setParam(params, "reason", account.Reason)
+	setParam(params, "private_key", account.PrivateKey)
+	if fields := additional_fields_param(ctx, account.AdditionalFields, account.SensitiveAdditionalFields, &resp.Diagnostics); fields != nil {
+		params["additional_fields"] = fields
+	}
+	setParam(params, "private_key_passphrase", account.PrivateKeyPassphrase)
+	if !check_password_policy(ctx, account.PasswordPolicyID, account.Password, &resp.Diagnostics) {
+		return
+	}
+	validate_account_type(ctx, account.AccountType.ValueString(), map[string]types.String{
+		"distinguished_name": account.DistinguishedName,
+		"account_alias":      account.AccountAlias,
+		"domain_name":        account.DomainName,
+	}, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if account.GeneratePassword.ValueBool() {
+		if account.Password.ValueString() != "" {
+			resp.Diagnostics.AddError("Conflicting Password Arguments", "password cannot be set together with generate_password.")
+			return
+		}
+		password, code, message := generate_password(ctx, account.PasswordPolicyID)
+		if code != 200 {
+			resp.Diagnostics.AddError(fmt.Sprintf("%d - %s", code, message), "")
+			return
+		}
+		params["password"] = password
+	}
 	added_account, code, message := add_account_function(ctx, params)
 	if code != 200 && code != 0 {
 		resp.Diagnostics.AddWarning(fmt.Sprintf("%d - %s", code, message), "")
@@ -152,6 +228,9 @@ func (d *AddAccount) Create(ctx context.Context, req datasource.ReadRequest, res
 }
 
 func (d *AddAccount) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if !check_configured(d.client, req, resp) {
+		return
+	}
 	var account AddAccountModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &account)...)
 	params := make(map[string]any)
@@ -160,7 +239,9 @@ func (d *AddAccount) Read(ctx context.Context, req datasource.ReadRequest, resp
 	setParam(params, "account_type", account.AccountType)
 	setParam(params, "ipaddress", account.IPAddress)
 	setParam(params, "notes", account.Notes)
-	setParam(params, "tags", account.Tags)
+	if tags := tags_param(with_default_tags(tag_values(account.Tags))); tags != "" {
+		params["tags"] = tags
+	}
 	setParam(params, "personal_account", account.PersonalAccount)
 	setParam(params, "folder_id", account.FolderID)
 	setParam(params, "password", account.Password)
@@ -168,6 +249,36 @@ func (d *AddAccount) Read(ctx context.Context, req datasource.ReadRequest, resp
 	setParam(params, "distinguished_name", account.DistinguishedName)
 	setParam(params, "account_alias", account.AccountAlias)
 	setParam(params, "domain_name", account.DomainName)
+	setParam(params, "password_policy_id", account.PasswordPolicyID)
+	setParam(params, "reason", account.Reason)
+	setParam(params, "private_key", account.PrivateKey)
+	if fields := additional_fields_param(ctx, account.AdditionalFields, account.SensitiveAdditionalFields, &resp.Diagnostics); fields != nil {
+		params["additional_fields"] = fields
+	}
+	setParam(params, "private_key_passphrase", account.PrivateKeyPassphrase)
+	if !check_password_policy(ctx, account.PasswordPolicyID, account.Password, &resp.Diagnostics) {
+		return
+	}
+	validate_account_type(ctx, account.AccountType.ValueString(), map[string]types.String{
+		"distinguished_name": account.DistinguishedName,
+		"account_alias":      account.AccountAlias,
+		"domain_name":        account.DomainName,
+	}, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if account.GeneratePassword.ValueBool() {
+		if account.Password.ValueString() != "" {
+			resp.Diagnostics.AddError("Conflicting Password Arguments", "password cannot be set together with generate_password.")
+			return
+		}
+		password, code, message := generate_password(ctx, account.PasswordPolicyID)
+		if code != 200 {
+			resp.Diagnostics.AddError(fmt.Sprintf("%d - %s", code, message), "")
+			return
+		}
+		params["password"] = password
+	}
 	added_account, code, message := add_account_function(ctx, params)
 	if code != 200 && code != 0 {
 		resp.Diagnostics.AddWarning(fmt.Sprintf("%d - %s", code, message), "")
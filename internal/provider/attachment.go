@@ -0,0 +1,103 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &Attachment{}
+
+func attachment() datasource.DataSource {
+	return &Attachment{}
+}
+
+type Attachment struct {
+	client *http.Client
+}
+
+type AttachmentModel struct {
+	AccountID     types.Int64  `tfsdk:"account_id"`
+	Name          types.String `tfsdk:"name"`
+	ID            types.Int64  `tfsdk:"id"`
+	Size          types.Int64  `tfsdk:"size"`
+	ContentBase64 types.String `tfsdk:"content_base64"`
+}
+
+func (d *Attachment) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_account_attachment"
+}
+
+func (d *Attachment) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Downloads a file attached to a Securden account.",
+
+		Attributes: map[string]schema.Attribute{
+			"account_id": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "Unique identifier of the account the file is attached to.",
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "File name of the attachment.",
+			},
+			"id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Unique identifier of the attachment.",
+			},
+			"size": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Size of the attachment in bytes.",
+			},
+			"content_base64": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "Base64 encoded content of the attachment.",
+			},
+		},
+	}
+}
+
+func (d *Attachment) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*http.Client)
+	if !ok {
+		resp.Diagnostics.AddWarning(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+func (d *Attachment) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if !check_configured(d.client, req, resp) {
+		return
+	}
+	var attachment AttachmentModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &attachment)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data, code, message := find_attachment(ctx, attachment.AccountID.ValueInt64(), attachment.Name.ValueString())
+	if code != 200 {
+		resp.Diagnostics.AddError(fmt.Sprintf("%d - %s", code, message), "")
+		return
+	}
+	content, code, message := download_attachment_function(ctx, attachment.AccountID.ValueInt64(), data.ID)
+	if code != 200 {
+		resp.Diagnostics.AddError(fmt.Sprintf("%d - %s", code, message), "")
+		return
+	}
+	attachment.ID = types.Int64Value(data.ID)
+	attachment.Size = types.Int64Value(data.Size)
+	attachment.ContentBase64 = types.StringValue(content)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &attachment)...)
+}
@@ -0,0 +1,103 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &Connection{}
+
+func connection() datasource.DataSource {
+	return &Connection{}
+}
+
+type Connection struct {
+	client *http.Client
+}
+
+type ConnectionModel struct {
+	AccountID types.Int64  `tfsdk:"account_id"`
+	Reason    types.String `tfsdk:"reason"`
+	Host      types.String `tfsdk:"host"`
+	Port      types.Int64  `tfsdk:"port"`
+	Protocol  types.String `tfsdk:"protocol"`
+	Gateway   types.String `tfsdk:"gateway"`
+}
+
+func (d *Connection) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_connection"
+}
+
+func (d *Connection) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Retrieves the connection details Securden uses to broker RDP and SSH sessions for an account.",
+
+		Attributes: map[string]schema.Attribute{
+			"account_id": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "Unique identifier of the account.",
+			},
+			"reason": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Reason recorded in the Securden audit trail for the lookup. Overrides the provider's `default_reason`.",
+			},
+			"host": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Host name or IP address of the target system.",
+			},
+			"port": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Port of the target system.",
+			},
+			"protocol": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Protocol of the session, e.g. `RDP` or `SSH`.",
+			},
+			"gateway": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The Securden gateway that brokers sessions to the target system. Empty when sessions are brokered by the server itself.",
+			},
+		},
+	}
+}
+
+func (d *Connection) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*http.Client)
+	if !ok {
+		resp.Diagnostics.AddWarning(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+func (d *Connection) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if !check_configured(d.client, req, resp) {
+		return
+	}
+	var connection ConnectionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &connection)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data, code, message := get_connection_function(ctx, connection.AccountID.ValueInt64(), connection.Reason.ValueString())
+	if code != 200 {
+		resp.Diagnostics.AddError(fmt.Sprintf("%d - %s", code, message), "")
+		return
+	}
+	connection.Host = types.StringValue(data.Host)
+	connection.Port = types.Int64Value(data.Port)
+	connection.Protocol = types.StringValue(data.Protocol)
+	connection.Gateway = types.StringValue(data.Gateway)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &connection)...)
+}
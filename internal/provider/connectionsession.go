@@ -0,0 +1,90 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ ephemeral.EphemeralResource = &ConnectionSession{}
+var _ ephemeral.EphemeralResourceWithConfigure = &ConnectionSession{}
+
+func connection_session() ephemeral.EphemeralResource {
+	return &ConnectionSession{}
+}
+
+type ConnectionSession struct {
+	client *http.Client
+}
+
+type ConnectionSessionModel struct {
+	AccountID  types.Int64  `tfsdk:"account_id"`
+	Reason     types.String `tfsdk:"reason"`
+	SessionURL types.String `tfsdk:"session_url"`
+}
+
+func (e *ConnectionSession) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_connection_session"
+}
+
+func (e *ConnectionSession) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Creates a one-time URL that launches a brokered RDP or SSH session for a Securden account, without storing it in plan or state.",
+
+		Attributes: map[string]schema.Attribute{
+			"account_id": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "Unique identifier of the account.",
+			},
+			"reason": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Reason recorded in the Securden audit trail for the session. Overrides the provider's `default_reason`.",
+			},
+			"session_url": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "One-time URL that launches a session for the account.",
+			},
+		},
+	}
+}
+
+func (e *ConnectionSession) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*http.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	e.client = client
+}
+
+func (e *ConnectionSession) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	if !check_resource_configured(e.client, &resp.Diagnostics) {
+		return
+	}
+	var session ConnectionSessionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &session)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	params := make(map[string]any)
+	setParam(params, "account_id", session.AccountID)
+	setParam(params, "reason", session.Reason)
+	sessionURL, code, message := launch_session_function(ctx, params)
+	if code != 200 {
+		resp.Diagnostics.AddError(fmt.Sprintf("%d - %s", code, message), "")
+		return
+	}
+	session.SessionURL = types.StringValue(sessionURL)
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &session)...)
+}
@@ -1,9 +1,38 @@
 package provider
 
+import "time"
+
 var const_authtoken = "authtoken"
 var certificate = "certificate"
-var default_port = ":443"
+var default_ports = map[string]string{
+	"http":  "80",
+	"https": "443",
+}
 var POST = "POST"
 var GET = "GET"
 var PUT = "PUT"
 var DELETE = "DELETE"
+var preflight_endpoint = "/api/token_details"
+var default_preflight_timeout = 30 * time.Second
+var certificate_fetch_timeout = 30 * time.Second
+var account_page_size = 100
+var max_account_page_size int64 = 1000
+var account_page_concurrency = 4
+var max_account_pages = 10000
+var default_accounts_limit int64 = 1000
+var share_permissions = []string{"view", "modify", "manage"}
+var poll_interval = 5 * time.Second
+var default_rotation_timeout int64 = 300
+var generated_password_length = 24
+var password_uppercase = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+var password_lowercase = "abcdefghijklmnopqrstuvwxyz"
+var password_digits = "0123456789"
+var password_special = "!@#$%^&*()-_=+[]{}:,.?"
+var default_checkout_duration int64 = 60
+var default_access_request_timeout int64 = 300
+var reason_header = "reason"
+var account_type_required_fields = map[string][]string{
+	"LDAP":             {"distinguished_name"},
+	"AWS IAM":          {"account_alias"},
+	"Google Workspace": {"domain_name"},
+}
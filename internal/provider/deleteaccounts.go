@@ -79,6 +79,9 @@ func (d *DeleteAccounts) Configure(ctx context.Context, req datasource.Configure
 }
 
 func (d *DeleteAccounts) Create(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if !check_configured(d.client, req, resp) {
+		return
+	}
 	var account DeleteAccountsModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &account)...)
 	params := make(map[string]any)
@@ -98,6 +101,9 @@ func (d *DeleteAccounts) Create(ctx context.Context, req datasource.ReadRequest,
 }
 
 func (d *DeleteAccounts) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if !check_configured(d.client, req, resp) {
+		return
+	}
 	var account DeleteAccountsModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &account)...)
 	params := make(map[string]any)
@@ -21,20 +21,24 @@ type EditAccount struct {
 }
 
 type EditAccountModel struct {
-	AccountID                 types.Int64  `tfsdk:"account_id"`
-	AccountName               types.String `tfsdk:"account_name"`
-	AccountTitle              types.String `tfsdk:"account_title"`
-	AccountType               types.String `tfsdk:"account_type"`
-	IPAddress                 types.String `tfsdk:"ipaddress"`
-	Notes                     types.String `tfsdk:"notes"`
-	Tags                      types.String `tfsdk:"tags"`
-	FolderID                  types.Int64  `tfsdk:"folder_id"`
-	OverwriteAdditionalFields types.Bool   `tfsdk:"overwrite_additional_fields"`
-	AccountExpirationDate     types.String `tfsdk:"account_expiration_date"`
-	DistinguishedName         types.String `tfsdk:"distinguished_name"`
-	AccountAlias              types.String `tfsdk:"account_alias"`
-	DomainName                types.String `tfsdk:"domain_name"`
-	Message                   types.String `tfsdk:"message"`
+	AccountID                 types.Int64    `tfsdk:"account_id"`
+	AccountName               types.String   `tfsdk:"account_name"`
+	AccountTitle              types.String   `tfsdk:"account_title"`
+	AccountType               types.String   `tfsdk:"account_type"`
+	IPAddress                 types.String   `tfsdk:"ipaddress"`
+	Notes                     types.String   `tfsdk:"notes"`
+	Tags                      []types.String `tfsdk:"tags"`
+	FolderID                  types.Int64    `tfsdk:"folder_id"`
+	OverwriteAdditionalFields types.Bool     `tfsdk:"overwrite_additional_fields"`
+	AccountExpirationDate     types.String   `tfsdk:"account_expiration_date"`
+	DistinguishedName         types.String   `tfsdk:"distinguished_name"`
+	AccountAlias              types.String   `tfsdk:"account_alias"`
+	DomainName                types.String   `tfsdk:"domain_name"`
+	PasswordPolicyID          types.Int64    `tfsdk:"password_policy_id"`
+	Reason                    types.String   `tfsdk:"reason"`
+	AdditionalFields          types.Map      `tfsdk:"additional_fields"`
+	SensitiveAdditionalFields types.Map      `tfsdk:"sensitive_additional_fields"`
+	Message                   types.String   `tfsdk:"message"`
 }
 
 func (d *EditAccount) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -70,8 +74,9 @@ func (d *EditAccount) Schema(ctx context.Context, req datasource.SchemaRequest,
 				MarkdownDescription: "Additional notes related to the account.",
 				Optional:            true,
 			},
-			"tags": schema.StringAttribute{
-				MarkdownDescription: "Tags associated with the account.",
+			"tags": schema.SetAttribute{
+				MarkdownDescription: "Tags of the account. When set, they replace the existing tags; the provider's `default_tags` are added to them.",
+				ElementType:         types.StringType,
 				Optional:            true,
 			},
 			"folder_id": schema.Int64Attribute{
@@ -98,6 +103,25 @@ func (d *EditAccount) Schema(ctx context.Context, req datasource.SchemaRequest,
 				MarkdownDescription: "Required for Google Workspace accounts.",
 				Optional:            true,
 			},
+			"additional_fields": schema.MapAttribute{
+				MarkdownDescription: "Additional (custom) fields of the account, keyed by field name.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"sensitive_additional_fields": schema.MapAttribute{
+				MarkdownDescription: "Additional fields holding secrets, e.g. API keys. Sent like `additional_fields` but masked in plan output.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"reason": schema.StringAttribute{
+				MarkdownDescription: "Reason recorded in the Securden audit trail. Overrides the provider's `default_reason`.",
+				Optional:            true,
+			},
+			"password_policy_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the password policy to attach to the account.",
+				Optional:            true,
+			},
 			"message": schema.StringAttribute{
 				MarkdownDescription: "Response message indicating the result of the operation.",
 				Computed:            true,
@@ -122,25 +146,32 @@ func (d *EditAccount) Configure(ctx context.Context, req datasource.ConfigureReq
 }
 
 func (d *EditAccount) Create(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if !check_configured(d.client, req, resp) {
+		return
+	}
 	var account EditAccountModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &account)...)
 	params := make(map[string]any)
-	logger("account.AccountID")
-	logger(account.AccountID)
 	setParam(params, "account_id", account.AccountID)
 	setParam(params, "account_title", account.AccountTitle)
 	setParam(params, "account_name", account.AccountName)
 	setParam(params, "account_type", account.AccountType)
 	setParam(params, "ipaddress", account.IPAddress)
 	setParam(params, "notes", account.Notes)
-	setParam(params, "tags", account.Tags)
+	if account.Tags != nil {
+		params["tags"] = tags_param(with_default_tags(tag_values(account.Tags)))
+	}
 	setParam(params, "folder_id", account.FolderID)
 	setParam(params, "overwrite_additional_fields", account.OverwriteAdditionalFields)
 	setParam(params, "account_expiration_date", account.AccountExpirationDate)
 	setParam(params, "distinguished_name", account.DistinguishedName)
 	setParam(params, "account_alias", account.AccountAlias)
 	setParam(params, "domain_name", account.DomainName)
-	logger(params)
+	setParam(params, "password_policy_id", account.PasswordPolicyID)
+	setParam(params, "reason", account.Reason)
+	if fields := additional_fields_param(ctx, account.AdditionalFields, account.SensitiveAdditionalFields, &resp.Diagnostics); fields != nil {
+		params["additional_fields"] = fields
+	}
 	edit_account, code, message := edit_account_function(ctx, params)
 	if code != 200 && code != 0 {
 		resp.Diagnostics.AddWarning(fmt.Sprintf("%d - %s", code, message), "")
@@ -150,6 +181,9 @@ func (d *EditAccount) Create(ctx context.Context, req datasource.ReadRequest, re
 }
 
 func (d *EditAccount) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if !check_configured(d.client, req, resp) {
+		return
+	}
 	var account EditAccountModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &account)...)
 	params := make(map[string]any)
@@ -159,14 +193,20 @@ func (d *EditAccount) Read(ctx context.Context, req datasource.ReadRequest, resp
 	setParam(params, "account_type", account.AccountType)
 	setParam(params, "ipaddress", account.IPAddress)
 	setParam(params, "notes", account.Notes)
-	setParam(params, "tags", account.Tags)
+	if account.Tags != nil {
+		params["tags"] = tags_param(with_default_tags(tag_values(account.Tags)))
+	}
 	setParam(params, "folder_id", account.FolderID)
 	setParam(params, "overwrite_additional_fields", account.OverwriteAdditionalFields)
 	setParam(params, "account_expiration_date", account.AccountExpirationDate)
 	setParam(params, "distinguished_name", account.DistinguishedName)
 	setParam(params, "account_alias", account.AccountAlias)
 	setParam(params, "domain_name", account.DomainName)
-	logger(params)
+	setParam(params, "password_policy_id", account.PasswordPolicyID)
+	setParam(params, "reason", account.Reason)
+	if fields := additional_fields_param(ctx, account.AdditionalFields, account.SensitiveAdditionalFields, &resp.Diagnostics); fields != nil {
+		params["additional_fields"] = fields
+	}
 	edit_account, code, message := edit_account_function(ctx, params)
 	if code != 200 && code != 0 {
 		resp.Diagnostics.AddWarning(fmt.Sprintf("%d - %s", code, message), "")
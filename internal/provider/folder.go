@@ -0,0 +1,111 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &Folder{}
+
+func folder() datasource.DataSource {
+	return &Folder{}
+}
+
+type Folder struct {
+	client *http.Client
+}
+
+type FolderModel struct {
+	ID          types.Int64  `tfsdk:"id"`
+	Path        types.String `tfsdk:"path"`
+	Name        types.String `tfsdk:"name"`
+	ParentID    types.Int64  `tfsdk:"parent_id"`
+	Description types.String `tfsdk:"description"`
+}
+
+func (d *Folder) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_folder"
+}
+
+func (d *Folder) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Looks up a Securden folder by ID or by path.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Unique identifier of the folder.",
+			},
+			"path": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Slash separated path of the folder, e.g. `Prod/Databases/Postgres`.",
+			},
+			"name": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The name of the folder.",
+			},
+			"parent_id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the parent folder. Zero for top level folders.",
+			},
+			"description": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Description of the folder.",
+			},
+		},
+	}
+}
+
+func (d *Folder) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*http.Client)
+	if !ok {
+		resp.Diagnostics.AddWarning(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+func (d *Folder) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if !check_configured(d.client, req, resp) {
+		return
+	}
+	var folder FolderModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &folder)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var data securdenFolder
+	var code int
+	var message string
+	if !folder.ID.IsNull() {
+		data, code, message = get_folder_function(ctx, folder.ID.ValueInt64())
+	} else if !folder.Path.IsNull() {
+		data, code, message = find_folder_by_path(ctx, folder.Path.ValueString())
+	} else {
+		resp.Diagnostics.AddError("Missing Folder Selector", "Either id or path must be set.")
+		return
+	}
+	if code != 200 {
+		resp.Diagnostics.AddError(fmt.Sprintf("%d - %s", code, message), "")
+		return
+	}
+	folder.ID = types.Int64Value(data.ID)
+	folder.Path = types.StringValue(data.Path)
+	folder.Name = types.StringValue(data.Name)
+	folder.ParentID = types.Int64Value(data.ParentID)
+	folder.Description = types.StringValue(data.Description)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &folder)...)
+}
@@ -0,0 +1,224 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &FolderResource{}
+var _ resource.ResourceWithImportState = &FolderResource{}
+
+func folder_resource() resource.Resource {
+	return &FolderResource{}
+}
+
+type FolderResource struct {
+	client *http.Client
+}
+
+type FolderResourceModel struct {
+	ID                 types.Int64  `tfsdk:"id"`
+	Name               types.String `tfsdk:"name"`
+	ParentID           types.Int64  `tfsdk:"parent_id"`
+	Description        types.String `tfsdk:"description"`
+	Path               types.String `tfsdk:"path"`
+	DeletionProtection types.Bool   `tfsdk:"deletion_protection"`
+	Reason             types.String `tfsdk:"reason"`
+}
+
+func (r *FolderResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_folder"
+}
+
+func (r *FolderResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a folder in Securden.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Unique identifier of the folder.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the folder.",
+			},
+			"parent_id": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "The ID of the parent folder. Omit to create a top level folder. Changing it moves the folder.",
+			},
+			"description": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Description of the folder.",
+			},
+			"deletion_protection": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "When `true`, destroying or replacing the folder fails. Set it to `false` in a prior apply to allow deletion.",
+			},
+			"reason": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Reason recorded in the Securden audit trail for the changes Terraform makes to the folder. Overrides the provider's `default_reason`.",
+			},
+			"path": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Slash separated path of the folder, e.g. `Prod/Databases/Postgres`.",
+			},
+		},
+	}
+}
+
+func (r *FolderResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*http.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+func (r *FolderResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !check_resource_configured(r.client, &resp.Diagnostics) {
+		return
+	}
+	var folder FolderResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &folder)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	params := make(map[string]any)
+	setParam(params, "folder_name", folder.Name)
+	setParam(params, "parent_folder_id", folder.ParentID)
+	setParam(params, "description", folder.Description)
+	setParam(params, "reason", folder.Reason)
+	id, code, message := add_folder_function(ctx, params)
+	if code != 200 {
+		resp.Diagnostics.AddError(fmt.Sprintf("%d - %s", code, message), "")
+		return
+	}
+	folder.ID = types.Int64Value(id)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), folder.ID)...)
+	if !read_folder_resource(ctx, &folder, &resp.Diagnostics) {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &folder)...)
+}
+
+func (r *FolderResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !check_resource_configured(r.client, &resp.Diagnostics) {
+		return
+	}
+	var folder FolderResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &folder)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data, code, message := get_folder_function(ctx, folder.ID.ValueInt64())
+	if code == 404 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if code != 200 {
+		resp.Diagnostics.AddError(fmt.Sprintf("%d - %s", code, message), "")
+		return
+	}
+	folder.Name = types.StringValue(data.Name)
+	folder.Path = types.StringValue(data.Path)
+	if data.ParentID != 0 || !folder.ParentID.IsNull() {
+		folder.ParentID = types.Int64Value(data.ParentID)
+	}
+	if data.Description != "" || !folder.Description.IsNull() {
+		folder.Description = types.StringValue(data.Description)
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &folder)...)
+}
+
+func (r *FolderResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !check_resource_configured(r.client, &resp.Diagnostics) {
+		return
+	}
+	var folder FolderResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &folder)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	params := make(map[string]any)
+	setParam(params, "folder_id", folder.ID)
+	setParam(params, "folder_name", folder.Name)
+	params["parent_folder_id"] = folder.ParentID.ValueInt64()
+	params["description"] = folder.Description.ValueString()
+	setParam(params, "reason", folder.Reason)
+	code, message := edit_folder_function(ctx, params)
+	if code != 200 {
+		resp.Diagnostics.AddError(fmt.Sprintf("%d - %s", code, message), "")
+		return
+	}
+	if !read_folder_resource(ctx, &folder, &resp.Diagnostics) {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &folder)...)
+}
+
+func (r *FolderResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !check_resource_configured(r.client, &resp.Diagnostics) {
+		return
+	}
+	var folder FolderResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &folder)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !check_deletion_protection("folder", folder.ID, folder.DeletionProtection, &resp.Diagnostics) {
+		return
+	}
+	params := make(map[string]any)
+	setParam(params, "folder_id", folder.ID)
+	setParam(params, "reason", folder.Reason)
+	code, message := delete_folder_function(ctx, params)
+	if code != 200 && code != 404 {
+		resp.Diagnostics.AddError(fmt.Sprintf("%d - %s", code, message), "")
+	}
+}
+
+func (r *FolderResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("Expected a numeric folder ID, got: %q", req.ID))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("deletion_protection"), false)...)
+}
+
+// read_folder_resource fills in the computed path of a folder after it was
+// created or updated.
+func read_folder_resource(ctx context.Context, folder *FolderResourceModel, diags *diag.Diagnostics) bool {
+	data, code, message := get_folder_function(ctx, folder.ID.ValueInt64())
+	if code != 200 {
+		diags.AddError(fmt.Sprintf("%d - %s", code, message), "")
+		return false
+	}
+	folder.Path = types.StringValue(data.Path)
+	return true
+}
@@ -0,0 +1,174 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &Folders{}
+
+func folders() datasource.DataSource {
+	return &Folders{}
+}
+
+type Folders struct {
+	client *http.Client
+}
+
+type FoldersModel struct {
+	RootID   types.Int64        `tfsdk:"root_id"`
+	RootPath types.String       `tfsdk:"root_path"`
+	Depth    types.Int64        `tfsdk:"depth"`
+	Folders  []FolderEntryModel `tfsdk:"folders"`
+}
+
+type FolderEntryModel struct {
+	ID           types.Int64  `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	Path         types.String `tfsdk:"path"`
+	ParentID     types.Int64  `tfsdk:"parent_id"`
+	Depth        types.Int64  `tfsdk:"depth"`
+	AccountCount types.Int64  `tfsdk:"account_count"`
+	Owner        types.String `tfsdk:"owner"`
+}
+
+func (d *Folders) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_folders"
+}
+
+func (d *Folders) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Retrieves the Securden folder tree.",
+
+		Attributes: map[string]schema.Attribute{
+			"root_id": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Only return this folder and its descendants.",
+			},
+			"root_path": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return the folder at this path and its descendants. Ignored when `root_id` is set.",
+			},
+			"depth": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Maximum depth below the root to return. `0` returns only the root folder (or only top level folders when no root is set).",
+			},
+			"folders": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The folders in the tree, ordered by path.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Unique identifier of the folder.",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the folder.",
+						},
+						"path": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Slash separated path of the folder.",
+						},
+						"parent_id": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The ID of the parent folder. Zero for top level folders.",
+						},
+						"depth": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Depth of the folder below the root, starting at 0.",
+						},
+						"account_count": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Number of accounts stored directly in the folder.",
+						},
+						"owner": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The owner of the folder.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *Folders) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*http.Client)
+	if !ok {
+		resp.Diagnostics.AddWarning(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+func (d *Folders) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if !check_configured(d.client, req, resp) {
+		return
+	}
+	var tree FoldersModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &tree)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !tree.Depth.IsNull() && tree.Depth.ValueInt64() < 0 {
+		resp.Diagnostics.AddError("Invalid Depth", "depth must not be negative.")
+		return
+	}
+	allFolders, code, message := get_folders_function(ctx)
+	if code != 200 {
+		resp.Diagnostics.AddError(fmt.Sprintf("%d - %s", code, message), "")
+		return
+	}
+
+	// With no root the top level folders sit at depth 0.
+	var rootID int64
+	rootDepth := 0
+	if !tree.RootID.IsNull() || !tree.RootPath.IsNull() {
+		var root securdenFolder
+		if !tree.RootID.IsNull() {
+			root, code, message = folder_by_id(allFolders, tree.RootID.ValueInt64())
+		} else {
+			root, code, message = folder_by_path(allFolders, tree.RootPath.ValueString())
+		}
+		if code != 200 {
+			resp.Diagnostics.AddError(fmt.Sprintf("%d - %s", code, message), "")
+			return
+		}
+		rootID = root.ID
+		rootDepth = root.Depth
+	}
+
+	tree.Folders = []FolderEntryModel{}
+	for _, folder := range sort_folders_by_path(allFolders) {
+		if rootID != 0 && folder.ID != rootID && !slices.Contains(folder.Ancestors, rootID) {
+			continue
+		}
+		depth := int64(folder.Depth - rootDepth)
+		if !tree.Depth.IsNull() && depth > tree.Depth.ValueInt64() {
+			continue
+		}
+		tree.Folders = append(tree.Folders, FolderEntryModel{
+			ID:           types.Int64Value(folder.ID),
+			Name:         types.StringValue(folder.Name),
+			Path:         types.StringValue(folder.Path),
+			ParentID:     types.Int64Value(folder.ParentID),
+			Depth:        types.Int64Value(depth),
+			AccountCount: types.Int64Value(folder.AccountCount),
+			Owner:        types.StringValue(folder.Owner),
+		})
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &tree)...)
+}
@@ -3,50 +3,122 @@ package provider
 import (
 	"bytes"
 	"context"
+	"crypto/rand"
+	"crypto/sha256"
 	"crypto/tls"
 	"crypto/x509"
+	"encoding/base64"
 	"encoding/json"
 	"encoding/pem"
 	"fmt"
 	"io/ioutil"
+	"math/big"
+	"mime/multipart"
+	"net"
 	"net/http"
 	"net/url"
 	"os"
 	"path/filepath"
 	"regexp"
+	"slices"
+	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
+	"unicode"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
-func logger(data interface{}) error {
-
-	strData := fmt.Sprintf("%v", data)
+// preflight_check calls an authenticated lightweight endpoint to confirm that the
+// server is reachable and that the configured auth token is accepted.
+func preflight_check(ctx context.Context, timeout time.Duration) (PreflightInfo, int, string) {
+	var info PreflightInfo
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	client, err := preflight_client(ctx)
+	if err != nil {
+		return info, 0, fmt.Sprintf("The server could not be reached: %v", err)
+	}
+	client.Timeout = timeout
 
-	file, err := os.OpenFile("log.txt", os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	reqURL, err := build_api_url(preflight_endpoint)
+	if err != nil {
+		return info, 500, fmt.Sprintf("failed to parse URL: %v", err)
+	}
+	apiRequest, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL.String(), nil)
 	if err != nil {
-		return fmt.Errorf("error opening file: %w", err)
+		return info, 500, fmt.Sprintf("failed to create request: %v", err)
 	}
-	defer file.Close()
+	apiRequest.Header.Set(const_authtoken, SecurdenAuthToken)
 
-	if _, err := file.WriteString(strData + "\n"); err != nil {
-		return fmt.Errorf("error writing to file: %w", err)
+	resp, err := client.Do(apiRequest)
+	if err != nil {
+		return info, 0, fmt.Sprintf("The server could not be reached: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden {
+		return info, resp.StatusCode, "The auth token was rejected by the server."
+	}
+	if resp.StatusCode >= 400 {
+		return info, resp.StatusCode, fmt.Sprintf("The server responded with HTTP %d.", resp.StatusCode)
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return info, 500, fmt.Sprintf("failed to read response body: %v", err)
+	}
+	var response struct {
+		ServerVersion string   `json:"server_version"`
+		Scopes        []string `json:"scopes"`
+		StatusCode    int      `json:"status_code"`
+		Message       string   `json:"message"`
+		Error         struct {
+			Code    interface{} `json:"code"`
+			Message string      `json:"message"`
+		} `json:"error"`
+	}
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return info, 500, fmt.Sprintf("Failed to parse response: %v", err)
+	}
+	if response.StatusCode != 200 && response.StatusCode != 0 {
+		errorMessage := response.Message
+		if response.Error.Message != "" {
+			errorMessage = response.Error.Message
+		}
+		return info, response.StatusCode, errorMessage
+	}
+	info.ServerVersion = response.ServerVersion
+	info.Scopes = response.Scopes
+	return info, 200, "Success"
 }
 
-func isServerReachable(serverURL string) bool {
-	client := createInsecureClient()
-	resp, err := client.Get(serverURL)
+// preflight_client builds the client used by the preflight check. Unlike get_client
+// it fetches the server certificate within the deadline of ctx and reports a
+// certificate that cannot be fetched or read instead of falling back to an
+// insecure client.
+func preflight_client(ctx context.Context) (*http.Client, error) {
+	if !strings.HasPrefix(SecurdenServerURL, "https") {
+		return &http.Client{}, nil
+	}
+	if SecurdenCertificate == "" {
+		cert, err := fetchSSLCertificate(ctx, SecurdenServerURL)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch the server certificate: %v", err)
+		}
+		return createSecureClient(cert), nil
+	}
+	cert, err := readPEMFile(SecurdenCertificate)
 	if err != nil {
-		return false
+		return nil, fmt.Errorf("failed to read the certificate: %v", err)
 	}
-	defer resp.Body.Close()
-	return resp.StatusCode < 400
+	return createSecureClient(cert), nil
 }
 
 func isValidURL(input string) bool {
@@ -55,23 +127,68 @@ func isValidURL(input string) bool {
 		return false
 	}
 
-	if strings.HasSuffix(parsedURL.Path, "/") {
+	if parsedURL.User != nil || parsedURL.RawQuery != "" || parsedURL.Fragment != "" {
 		return false
 	}
 
-	host, portStr, found := strings.Cut(parsedURL.Host, ":")
-	if !found || host == "" || portStr == "" {
+	if portStr := parsedURL.Port(); portStr != "" {
+		port, err := strconv.Atoi(portStr)
+		if err != nil || port < 1 || port > 65535 {
+			return false
+		}
+	} else if strings.HasSuffix(parsedURL.Host, ":") {
 		return false
 	}
 
-	port, err := strconv.Atoi(portStr)
-	if err != nil || port < 1 || port > 65535 {
+	host := parsedURL.Hostname()
+	if host == "" {
 		return false
 	}
 
-	hostnameRegex := `^(localhost|([a-zA-Z0-9-]+\.)+[a-zA-Z]{2,}|(\d{1,3}\.){3}\d{1,3})$`
+	if strings.HasPrefix(parsedURL.Host, "[") {
+		ip := net.ParseIP(host)
+		return ip != nil && ip.To4() == nil
+	}
+
+	if ip := net.ParseIP(host); ip != nil {
+		return ip.To4() != nil
+	}
+
+	hostnameRegex := `^([a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?)(\.[a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?)*$`
 	matched, _ := regexp.MatchString(hostnameRegex, host)
-	return matched
+	if !matched {
+		return false
+	}
+
+	// Dotted all-numeric hosts that failed net.ParseIP are malformed IPv4 addresses.
+	labels := strings.Split(host, ".")
+	if _, err := strconv.Atoi(labels[len(labels)-1]); err == nil {
+		return false
+	}
+	return true
+}
+
+// normalizeServerURL trims trailing slashes so the configured URL can be used as
+// a base path for the API endpoints.
+func normalizeServerURL(serverURL string) string {
+	return strings.TrimRight(serverURL, "/")
+}
+
+// build_api_url joins an API endpoint onto the configured server URL, keeping any
+// context path the server is deployed under (e.g. https://host/securden).
+func build_api_url(apiURL string) (*url.URL, error) {
+	baseURL, err := url.Parse(SecurdenServerURL)
+	if err != nil {
+		return nil, err
+	}
+	endpoint, err := url.Parse(apiURL)
+	if err != nil {
+		return nil, err
+	}
+	baseURL.Path = strings.TrimRight(baseURL.Path, "/") + "/" + strings.TrimLeft(endpoint.Path, "/")
+	baseURL.RawPath = ""
+	baseURL.RawQuery = endpoint.RawQuery
+	return baseURL, nil
 }
 
 func isValidPEMFile(filePath string) bool {
@@ -115,16 +232,17 @@ func setParam(params map[string]any, key string, value attr.Value) {
 	}
 }
 
-func fetchSSLCertificate(serverURL string) (*x509.Certificate, error) {
+func fetchSSLCertificate(ctx context.Context, serverURL string) (*x509.Certificate, error) {
 	parsedURL, err := url.Parse(serverURL)
 	if err != nil {
 		return nil, fmt.Errorf("invalid URL: %v", err)
 	}
 
-	host := parsedURL.Host
-	if parsedURL.Port() == "" {
-		host += default_port
+	port := parsedURL.Port()
+	if port == "" {
+		port = default_ports[parsedURL.Scheme]
 	}
+	host := net.JoinHostPort(parsedURL.Hostname(), port)
 
 	// Custom TLS config that skips hostname verification
 	tlsConfig := &tls.Config{
@@ -135,13 +253,14 @@ func fetchSSLCertificate(serverURL string) (*x509.Certificate, error) {
 		},
 	}
 
-	conn, err := tls.Dial("tcp", host, tlsConfig)
+	dialer := &tls.Dialer{Config: tlsConfig}
+	conn, err := dialer.DialContext(ctx, "tcp", host)
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect: %v", err)
 	}
 	defer conn.Close()
 
-	certs := conn.ConnectionState().PeerCertificates
+	certs := conn.(*tls.Conn).ConnectionState().PeerCertificates
 	if len(certs) == 0 {
 		return nil, fmt.Errorf("no certificates found")
 	}
@@ -168,7 +287,6 @@ func createSecureClient(cert *x509.Certificate) *http.Client {
 }
 
 func createInsecureClient() *http.Client {
-	fmt.Sprintf("Creating insecure HTTP client")
 	tlsConfig := &tls.Config{
 		InsecureSkipVerify: true,
 	}
@@ -199,37 +317,36 @@ func readPEMFile(filePath string) (*x509.Certificate, error) {
 	return cert, nil
 }
 
-func raise_request(params map[string]any, apiURL string, method string) ([]byte, error) {
-	logger(params)
+// get_client builds the HTTP client used to talk to the configured server, using
+// the configured or auto-fetched certificate when one is available.
+func get_client() *http.Client {
 	pattern := regexp.MustCompile("^https")
-	var client *http.Client
-	var err error
-
-	if pattern.MatchString(SecurdenServerURL) {
-		if len(SecurdenCertificate) == 0 {
-			cert, certErr := fetchSSLCertificate(SecurdenServerURL)
-			if certErr != nil {
-				client = createInsecureClient()
-			} else {
-				client = createSecureClient(cert)
-			}
-		} else if filepath.IsAbs(SecurdenCertificate) {
-			cert, certErr := readPEMFile(SecurdenCertificate)
-			if certErr != nil {
-				client = createInsecureClient()
-			} else {
-				client = createSecureClient(cert)
-			}
-		} else {
-			client = createInsecureClient()
+	if !pattern.MatchString(SecurdenServerURL) {
+		return &http.Client{}
+	}
+	if len(SecurdenCertificate) == 0 {
+		ctx, cancel := context.WithTimeout(context.Background(), certificate_fetch_timeout)
+		defer cancel()
+		cert, certErr := fetchSSLCertificate(ctx, SecurdenServerURL)
+		if certErr != nil {
+			return createInsecureClient()
 		}
-	} else {
-		client = &http.Client{}
+		return createSecureClient(cert)
+	}
+	if filepath.IsAbs(SecurdenCertificate) {
+		cert, certErr := readPEMFile(SecurdenCertificate)
+		if certErr != nil {
+			return createInsecureClient()
+		}
+		return createSecureClient(cert)
 	}
+	return createInsecureClient()
+}
 
-	apiURL = SecurdenServerURL + apiURL
+func raise_request(params map[string]any, apiURL string, method string) ([]byte, error) {
+	client := get_client()
 
-	reqURL, err := url.Parse(apiURL)
+	reqURL, err := build_api_url(apiURL)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse URL: %v", err)
 	}
@@ -250,6 +367,10 @@ func raise_request(params map[string]any, apiURL string, method string) ([]byte,
 				for _, num := range v {
 					q.Add(key, fmt.Sprintf("%d", num))
 				}
+			case []string:
+				for _, item := range v {
+					q.Add(key, item)
+				}
 			default:
 			}
 		}
@@ -285,11 +406,54 @@ func raise_request(params map[string]any, apiURL string, method string) ([]byte,
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %v", err)
 	}
+	if reason := request_reason(params); reason != "" {
+		apiRequest.Header.Set(reason_header, reason)
+	}
+
+	return send_request(client, apiRequest)
+}
+
+// request_reason returns the reason sent with a request: the reason parameter of
+// the request when it has one, otherwise the provider default_reason.
+func request_reason(params map[string]any) string {
+	switch v := params["reason"].(type) {
+	case string:
+		if v != "" {
+			return v
+		}
+	case types.String:
+		if v.ValueString() != "" {
+			return v.ValueString()
+		}
+	}
+	return SecurdenDefaultReason
+}
+
+// render_reason expands the {workspace} and {run_id} placeholders of a
+// default_reason template from the Terraform and HCP Terraform environment.
+func render_reason(template string) string {
+	workspace := os.Getenv("TF_WORKSPACE")
+	if workspace == "" {
+		workspace = os.Getenv("TFC_WORKSPACE_NAME")
+	}
+	if workspace == "" {
+		workspace = "default"
+	}
+	return strings.NewReplacer("{workspace}", workspace, "{run_id}", os.Getenv("TFC_RUN_ID")).Replace(template)
+}
 
+// send_request adds the auth token to apiRequest and returns the response body,
+// retrying once with an insecure client when the request fails.
+func send_request(client *http.Client, apiRequest *http.Request) ([]byte, error) {
 	apiRequest.Header.Set(const_authtoken, SecurdenAuthToken)
 
 	resp, err := client.Do(apiRequest)
 	if err != nil {
+		if apiRequest.GetBody != nil {
+			if apiRequest.Body, err = apiRequest.GetBody(); err != nil {
+				return nil, fmt.Errorf("failed to rewind request body: %v", err)
+			}
+		}
 		client = createInsecureClient()
 		resp, err = client.Do(apiRequest)
 		if err != nil {
@@ -305,7 +469,46 @@ func raise_request(params map[string]any, apiURL string, method string) ([]byte,
 	return body, nil
 }
 
-func get_account(ctx context.Context, account_id int64, account_name, account_title, account_type string) (AccountModel, int, string) {
+// raise_multipart_request uploads content as a multipart/form-data file part named
+// fieldName, together with params as plain form fields.
+func raise_multipart_request(params map[string]any, fieldName, fileName string, content []byte, apiURL string) ([]byte, error) {
+	client := get_client()
+
+	reqURL, err := build_api_url(apiURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse URL: %v", err)
+	}
+
+	var requestBody bytes.Buffer
+	writer := multipart.NewWriter(&requestBody)
+	for key, value := range params {
+		if err := writer.WriteField(key, fmt.Sprintf("%v", value)); err != nil {
+			return nil, fmt.Errorf("failed to write form field %s: %v", key, err)
+		}
+	}
+	part, err := writer.CreateFormFile(fieldName, fileName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create form file: %v", err)
+	}
+	if _, err := part.Write(content); err != nil {
+		return nil, fmt.Errorf("failed to write form file: %v", err)
+	}
+	if err := writer.Close(); err != nil {
+		return nil, fmt.Errorf("failed to serialize request body: %v", err)
+	}
+
+	apiRequest, err := http.NewRequest(http.MethodPost, reqURL.String(), bytes.NewReader(requestBody.Bytes()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %v", err)
+	}
+	apiRequest.Header.Set("Content-Type", writer.FormDataContentType())
+	if reason := request_reason(params); reason != "" {
+		apiRequest.Header.Set(reason_header, reason)
+	}
+	return send_request(client, apiRequest)
+}
+
+func get_account(ctx context.Context, account_id int64, account_name, account_title, account_type, reason string) (AccountModel, int, string) {
 	var account AccountModel
 	params := make(map[string]any)
 	if account_id != 0 {
@@ -314,6 +517,7 @@ func get_account(ctx context.Context, account_id int64, account_name, account_ti
 	setParam(params, "account_name", types.StringValue(account_name))
 	setParam(params, "account_title", types.StringValue(account_title))
 	setParam(params, "account_type", types.StringValue(account_type))
+	setParam(params, "reason", types.StringValue(reason))
 	body, err := raise_request(params, "/secretsmanagement/get_account", GET)
 	if err != nil {
 		return account, 500, fmt.Sprintf("Error in API call: %v", err)
@@ -344,15 +548,11 @@ func get_account(ctx context.Context, account_id int64, account_name, account_ti
 		case string:
 			accountData[key] = types.StringValue(v)
 		case float64:
-			accountData[key] = types.StringValue(fmt.Sprintf("%v", v))
+			accountData[key] = types.StringValue(format_value(v))
 		case map[string]interface{}:
 			nestedData := make(map[string]attr.Value)
 			for nestedKey, nestedValue := range v {
-				if nestedStr, ok := nestedValue.(string); ok {
-					nestedData[nestedKey] = types.StringValue(nestedStr)
-				} else {
-					nestedData[nestedKey] = types.StringValue(fmt.Sprintf("%v", nestedValue))
-				}
+				nestedData[nestedKey] = types.StringValue(format_value(nestedValue))
 			}
 			accountData[key], _ = types.MapValue(types.StringType, nestedData)
 		default:
@@ -364,17 +564,32 @@ func get_account(ctx context.Context, account_id int64, account_name, account_ti
 }
 
 func get_accounts(ctx context.Context, params map[string]any) (map[string]map[string]string, int, string) {
+	accounts, _, code, message := get_accounts_page(ctx, params)
+	return accounts, code, message
+}
+
+// get_accounts_page performs a single /secretsmanagement/get_accounts call and also
+// returns the total_count reported by the server, or -1 when it is not reported.
+func get_accounts_page(ctx context.Context, params map[string]any) (map[string]map[string]string, int, int, string) {
 	var accounts_data = make(map[string]any)
 	var null map[string]map[string]string
 
 	body, err := raise_request(params, "/secretsmanagement/get_accounts", POST)
 	if err != nil {
-		return null, 500, fmt.Sprintf("Error in API call: %v", err)
+		return null, -1, 500, fmt.Sprintf("Error in API call: %v", err)
 	}
 
 	err = json.Unmarshal(body, &accounts_data)
 	if err != nil {
-		return null, 500, fmt.Sprintf("Error parsing response: %v", err)
+		return null, -1, 500, fmt.Sprintf("Error parsing response: %v", err)
+	}
+	if statusCode, ok := accounts_data["status_code"].(float64); ok && statusCode != 200 {
+		return null, -1, int(statusCode), response_message(accounts_data)
+	}
+
+	total := -1
+	if totalCount, ok := accounts_data["total_count"].(float64); ok {
+		total = int(totalCount)
 	}
 
 	processedAccounts := make(map[string]map[string]string)
@@ -387,17 +602,157 @@ func get_accounts(ctx context.Context, params map[string]any) (map[string]map[st
 
 		processedEntry := make(map[string]string)
 		for k, v := range accountMap {
-			if v == nil {
-				processedEntry[k] = ""
-			} else {
-				processedEntry[k] = fmt.Sprintf("%v", v)
-			}
+			processedEntry[k] = format_value(v)
 		}
 
 		processedAccounts[key] = processedEntry
 	}
 
-	return processedAccounts, 200, "Success"
+	return processedAccounts, total, 200, "Success"
+}
+
+type accountsPage struct {
+	accounts map[string]map[string]string
+	total    int
+	code     int
+	message  string
+}
+
+// search_accounts pages through /secretsmanagement/get_accounts using offset and
+// limit until the server runs out of results or max accounts have matched. Up to
+// concurrency pages are requested at once, but results are always consumed in page
+// order; within a page the IDs are sorted numerically.
+func search_accounts(ctx context.Context, params map[string]any, max int, pageSize int, concurrency int, match func(map[string]string) bool) ([]string, map[string]map[string]string, bool, int, string) {
+	var ids []string
+	accounts := make(map[string]map[string]string)
+	seen := make(map[string]bool)
+	total := -1
+
+	for next := 0; ; {
+		if next >= max_account_pages {
+			return nil, nil, false, 500, fmt.Sprintf("Stopped after %d pages of accounts without reaching the end of the results.", max_account_pages)
+		}
+		// The first page is fetched on its own so the reported total can bound the
+		// concurrent batches that follow.
+		batch := concurrency
+		if next == 0 {
+			batch = 1
+		}
+		if total >= 0 {
+			remaining := (total+pageSize-1)/pageSize - next
+			if remaining <= 0 {
+				break
+			}
+			if remaining < batch {
+				batch = remaining
+			}
+		}
+		first := next
+		next += batch
+
+		pages := make([]accountsPage, batch)
+		var wg sync.WaitGroup
+		for i := 0; i < batch; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				pageParams := make(map[string]any, len(params)+2)
+				for key, value := range params {
+					pageParams[key] = value
+				}
+				pageParams["offset"] = (first + i) * pageSize
+				pageParams["limit"] = pageSize
+				page := &pages[i]
+				page.accounts, page.total, page.code, page.message = get_accounts_page(ctx, pageParams)
+			}(i)
+		}
+		wg.Wait()
+
+		for _, page := range pages {
+			if page.code != 200 {
+				return nil, nil, false, page.code, page.message
+			}
+			if page.total >= 0 {
+				total = page.total
+			}
+			// A server that ignores offset returns the same accounts on every page,
+			// so a page without new accounts ends the search.
+			added := false
+			for _, id := range sorted_account_ids(page.accounts) {
+				if seen[id] {
+					continue
+				}
+				seen[id] = true
+				added = true
+				if !match(page.accounts[id]) {
+					continue
+				}
+				if len(ids) == max {
+					return ids, accounts, true, 200, "Success"
+				}
+				ids = append(ids, id)
+				accounts[id] = page.accounts[id]
+			}
+			if len(page.accounts) < pageSize || !added {
+				return ids, accounts, false, 200, "Success"
+			}
+		}
+	}
+	return ids, accounts, false, 200, "Success"
+}
+
+// sorted_account_ids returns the keys of an accounts map ordered numerically.
+func sorted_account_ids(accounts map[string]map[string]string) []string {
+	ids := make([]string, 0, len(accounts))
+	for id := range accounts {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		a, errA := strconv.ParseInt(ids[i], 10, 64)
+		b, errB := strconv.ParseInt(ids[j], 10, 64)
+		if errA != nil || errB != nil {
+			return ids[i] < ids[j]
+		}
+		return a < b
+	})
+	return ids
+}
+
+// glob_to_regexp converts a pattern using * and ? wildcards into a
+// case-insensitive regular expression matching the whole value.
+func glob_to_regexp(pattern string) (*regexp.Regexp, error) {
+	quoted := regexp.QuoteMeta(pattern)
+	quoted = strings.ReplaceAll(quoted, `\*`, ".*")
+	quoted = strings.ReplaceAll(quoted, `\?`, ".")
+	return regexp.Compile("(?i)^" + quoted + "$")
+}
+
+// format_value renders a decoded JSON value as a string. Numbers are written out in
+// full so that large account and folder IDs do not switch to exponent notation.
+func format_value(value any) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
+
+// response_message extracts the most specific error message from an API response.
+func response_message(response map[string]any) string {
+	if errMsg, exists := response["error"].(map[string]interface{}); exists {
+		if msg, ok := errMsg["message"].(string); ok && msg != "" {
+			return msg
+		}
+	}
+	if msg, ok := response["message"].(string); ok {
+		return msg
+	}
+	return "Unknown error"
 }
 
 func get_passwords(ctx context.Context, accountIDs []string) (types.Map, int, string) {
@@ -548,3 +903,1126 @@ func edit_account_function(ctx context.Context, params map[string]any) (EditAcco
 	account.Message = types.StringValue(response.Message)
 	return account, response.StatusCode, response.Message
 }
+
+// apiStatus holds the status fields shared by every Securden API response.
+type apiStatus struct {
+	StatusCode int    `json:"status_code"`
+	Message    string `json:"message"`
+	Error      struct {
+		Code    interface{} `json:"code"`
+		Message string      `json:"message"`
+	} `json:"error"`
+}
+
+// result returns the status code and message of the response, preferring the
+// error message when the call failed.
+func (s apiStatus) result() (int, string) {
+	if s.StatusCode != 200 && s.StatusCode != 0 {
+		errorMessage := s.Message
+		if s.Error.Message != "" {
+			errorMessage = s.Error.Message
+		}
+		return s.StatusCode, errorMessage
+	}
+	return 200, s.Message
+}
+
+// call_api raises a request and decodes the JSON response into target, which must
+// embed apiStatus.
+func call_api(params map[string]any, apiURL string, method string, target interface{ result() (int, string) }) (int, string) {
+	body, err := raise_request(params, apiURL, method)
+	if err != nil {
+		return 500, fmt.Sprintf("Error in API call: %v", err)
+	}
+	err = json.Unmarshal(body, target)
+	if err != nil {
+		return 500, fmt.Sprintf("Failed to parse response: %v", err)
+	}
+	return target.result()
+}
+
+type securdenFolder struct {
+	ID           int64   `json:"id"`
+	Name         string  `json:"name"`
+	ParentID     int64   `json:"parent_id"`
+	Description  string  `json:"description"`
+	AccountCount int64   `json:"account_count"`
+	Owner        string  `json:"owner"`
+	Path         string  `json:"-"`
+	Depth        int     `json:"-"`
+	Ancestors    []int64 `json:"-"`
+}
+
+// get_folders_function returns every folder visible to the auth token with its
+// full path (e.g. Prod/Databases/Postgres) filled in.
+func get_folders_function(ctx context.Context) ([]securdenFolder, int, string) {
+	var response struct {
+		apiStatus
+		Folders []securdenFolder `json:"folders"`
+	}
+	code, message := call_api(map[string]any{}, "/api/get_folders", GET, &response)
+	if code != 200 {
+		return nil, code, message
+	}
+
+	byID := make(map[int64]*securdenFolder, len(response.Folders))
+	for i := range response.Folders {
+		byID[response.Folders[i].ID] = &response.Folders[i]
+	}
+	for i := range response.Folders {
+		folder := &response.Folders[i]
+		names := []string{folder.Name}
+		visited := map[int64]bool{folder.ID: true}
+		for parent, ok := byID[folder.ParentID]; ok && !visited[parent.ID]; parent, ok = byID[parent.ParentID] {
+			visited[parent.ID] = true
+			names = append([]string{parent.Name}, names...)
+			folder.Ancestors = append(folder.Ancestors, parent.ID)
+		}
+		folder.Path = strings.Join(names, "/")
+		folder.Depth = len(names) - 1
+	}
+	return response.Folders, 200, "Success"
+}
+
+// sort_folders_by_path returns the folders ordered by path, then by ID.
+func sort_folders_by_path(folders []securdenFolder) []securdenFolder {
+	sorted := append([]securdenFolder(nil), folders...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].Path != sorted[j].Path {
+			return sorted[i].Path < sorted[j].Path
+		}
+		return sorted[i].ID < sorted[j].ID
+	})
+	return sorted
+}
+
+// get_folder_function looks up a single folder by ID. A 404 code is returned when
+// the folder does not exist.
+func get_folder_function(ctx context.Context, folderID int64) (securdenFolder, int, string) {
+	folders, code, message := get_folders_function(ctx)
+	if code != 200 {
+		return securdenFolder{}, code, message
+	}
+	return folder_by_id(folders, folderID)
+}
+
+// find_folder_by_path resolves a slash separated folder path to a folder.
+func find_folder_by_path(ctx context.Context, folderPath string) (securdenFolder, int, string) {
+	folders, code, message := get_folders_function(ctx)
+	if code != 200 {
+		return securdenFolder{}, code, message
+	}
+	return folder_by_path(folders, folderPath)
+}
+
+func folder_by_id(folders []securdenFolder, folderID int64) (securdenFolder, int, string) {
+	for _, folder := range folders {
+		if folder.ID == folderID {
+			return folder, 200, "Success"
+		}
+	}
+	return securdenFolder{}, 404, fmt.Sprintf("Folder %d not found", folderID)
+}
+
+func folder_by_path(folders []securdenFolder, folderPath string) (securdenFolder, int, string) {
+	var parts []string
+	for _, part := range strings.Split(folderPath, "/") {
+		if part = strings.TrimSpace(part); part != "" {
+			parts = append(parts, part)
+		}
+	}
+	wanted := strings.Join(parts, "/")
+	var matches []securdenFolder
+	for _, folder := range folders {
+		if folder.Path == wanted {
+			matches = append(matches, folder)
+		}
+	}
+	if len(matches) == 0 {
+		return securdenFolder{}, 404, fmt.Sprintf("Folder %q not found", folderPath)
+	}
+	if len(matches) > 1 {
+		return securdenFolder{}, 409, fmt.Sprintf("Folder path %q matches %d folders", folderPath, len(matches))
+	}
+	return matches[0], 200, "Success"
+}
+
+func add_folder_function(ctx context.Context, params map[string]any) (int64, int, string) {
+	var response struct {
+		apiStatus
+		ID int64 `json:"ID"`
+	}
+	code, message := call_api(params, "/api/add_folder", POST, &response)
+	return response.ID, code, message
+}
+
+func edit_folder_function(ctx context.Context, params map[string]any) (int, string) {
+	var response struct {
+		apiStatus
+	}
+	return call_api(params, "/api/edit_folder", PUT, &response)
+}
+
+func delete_folder_function(ctx context.Context, params map[string]any) (int, string) {
+	var response struct {
+		apiStatus
+	}
+	return call_api(params, "/api/delete_folder", DELETE, &response)
+}
+
+type securdenShare struct {
+	UserID      int64  `json:"user_id"`
+	UserGroupID int64  `json:"user_group_id"`
+	Permission  string `json:"permission"`
+}
+
+// get_shares_function lists the users and user groups an account or folder is
+// shared with. kind is either "account" or "folder".
+func get_shares_function(ctx context.Context, kind string, id int64) ([]securdenShare, int, string) {
+	var response struct {
+		apiStatus
+		Shares []securdenShare `json:"shares"`
+	}
+	params := map[string]any{kind + "_id": id}
+	code, message := call_api(params, "/api/get_"+kind+"_shares", GET, &response)
+	if code != 200 {
+		return nil, code, message
+	}
+	return response.Shares, 200, "Success"
+}
+
+// share_function grants or updates a permission on an account or folder.
+func share_function(ctx context.Context, kind string, params map[string]any) (int, string) {
+	var response struct {
+		apiStatus
+	}
+	return call_api(params, "/api/share_"+kind, POST, &response)
+}
+
+// unshare_function revokes a permission on an account or folder.
+func unshare_function(ctx context.Context, kind string, params map[string]any) (int, string) {
+	var response struct {
+		apiStatus
+	}
+	return call_api(params, "/api/unshare_"+kind, DELETE, &response)
+}
+
+// validate_share_config checks that exactly one principal is set and that the
+// permission is one Securden understands.
+func validate_share_config(userID, userGroupID types.Int64, permission types.String, diags *diag.Diagnostics) {
+	if userID.IsUnknown() || userGroupID.IsUnknown() {
+		return
+	}
+	if userID.IsNull() == userGroupID.IsNull() {
+		diags.AddError("Invalid Share Principal", "Exactly one of user_id or user_group_id must be set.")
+	}
+	if !permission.IsNull() && !permission.IsUnknown() && !slices.Contains(share_permissions, permission.ValueString()) {
+		diags.AddError("Invalid Permission", fmt.Sprintf("permission must be one of %s, got: %q", strings.Join(share_permissions, ", "), permission.ValueString()))
+	}
+}
+
+// share_id builds the ID of a share, e.g. 2000000001800:user_group:15.
+func share_id(targetID int64, userID, userGroupID types.Int64) string {
+	if !userGroupID.IsNull() {
+		return fmt.Sprintf("%d:user_group:%d", targetID, userGroupID.ValueInt64())
+	}
+	return fmt.Sprintf("%d:user:%d", targetID, userID.ValueInt64())
+}
+
+// parse_share_id splits a share ID into the target ID and principal. The principal
+// that is not part of the ID is returned as null.
+func parse_share_id(id string) (int64, types.Int64, types.Int64, error) {
+	parts := strings.Split(id, ":")
+	if len(parts) != 3 {
+		return 0, types.Int64Null(), types.Int64Null(), fmt.Errorf("expected 3 parts, got %d", len(parts))
+	}
+	targetID, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return 0, types.Int64Null(), types.Int64Null(), err
+	}
+	principalID, err := strconv.ParseInt(parts[2], 10, 64)
+	if err != nil {
+		return 0, types.Int64Null(), types.Int64Null(), err
+	}
+	switch parts[1] {
+	case "user":
+		return targetID, types.Int64Value(principalID), types.Int64Null(), nil
+	case "user_group":
+		return targetID, types.Int64Null(), types.Int64Value(principalID), nil
+	}
+	return 0, types.Int64Null(), types.Int64Null(), fmt.Errorf("unknown principal type %q", parts[1])
+}
+
+// find_share returns the share granted to the given user or user group.
+func find_share(shares []securdenShare, userID, userGroupID types.Int64) (securdenShare, bool) {
+	for _, share := range shares {
+		if !userID.IsNull() && share.UserID == userID.ValueInt64() {
+			return share, true
+		}
+		if !userGroupID.IsNull() && share.UserGroupID == userGroupID.ValueInt64() {
+			return share, true
+		}
+	}
+	return securdenShare{}, false
+}
+
+type securdenUser struct {
+	ID         int64  `json:"id"`
+	Username   string `json:"username"`
+	Email      string `json:"email"`
+	Role       string `json:"role"`
+	AuthSource string `json:"auth_source"`
+	Enabled    bool   `json:"enabled"`
+}
+
+// get_user_function looks up a user by ID or, when userID is zero, by username.
+func get_user_function(ctx context.Context, userID int64, username string) (securdenUser, int, string) {
+	var response struct {
+		apiStatus
+		User securdenUser `json:"user"`
+	}
+	params := make(map[string]any)
+	if userID != 0 {
+		params["user_id"] = userID
+	}
+	if username != "" {
+		params["username"] = username
+	}
+	code, message := call_api(params, "/api/get_user", GET, &response)
+	return response.User, code, message
+}
+
+func add_user_function(ctx context.Context, params map[string]any) (int64, int, string) {
+	var response struct {
+		apiStatus
+		ID int64 `json:"ID"`
+	}
+	code, message := call_api(params, "/api/add_user", POST, &response)
+	return response.ID, code, message
+}
+
+func edit_user_function(ctx context.Context, params map[string]any) (int, string) {
+	var response struct {
+		apiStatus
+	}
+	return call_api(params, "/api/edit_user", PUT, &response)
+}
+
+func delete_user_function(ctx context.Context, params map[string]any) (int, string) {
+	var response struct {
+		apiStatus
+	}
+	return call_api(params, "/api/delete_user", DELETE, &response)
+}
+
+type securdenUserGroup struct {
+	ID          int64    `json:"id"`
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	Members     []int64  `json:"members"`
+	ADGroups    []string `json:"ad_groups"`
+}
+
+// get_user_group_function looks up a user group by ID or, when groupID is zero,
+// by name.
+func get_user_group_function(ctx context.Context, groupID int64, name string) (securdenUserGroup, int, string) {
+	var response struct {
+		apiStatus
+		UserGroup securdenUserGroup `json:"user_group"`
+	}
+	params := make(map[string]any)
+	if groupID != 0 {
+		params["user_group_id"] = groupID
+	}
+	if name != "" {
+		params["user_group_name"] = name
+	}
+	code, message := call_api(params, "/api/get_user_group", GET, &response)
+	return response.UserGroup, code, message
+}
+
+func add_user_group_function(ctx context.Context, params map[string]any) (int64, int, string) {
+	var response struct {
+		apiStatus
+		ID int64 `json:"ID"`
+	}
+	code, message := call_api(params, "/api/add_user_group", POST, &response)
+	return response.ID, code, message
+}
+
+func edit_user_group_function(ctx context.Context, params map[string]any) (int, string) {
+	var response struct {
+		apiStatus
+	}
+	return call_api(params, "/api/edit_user_group", PUT, &response)
+}
+
+func delete_user_group_function(ctx context.Context, params map[string]any) (int, string) {
+	var response struct {
+		apiStatus
+	}
+	return call_api(params, "/api/delete_user_group", DELETE, &response)
+}
+
+type securdenResetResult struct {
+	Status  string `json:"status"`
+	Message string `json:"message"`
+}
+
+// reset_passwords_function asks Securden to remote-reset the passwords of the given
+// accounts and returns the ID of the reset request.
+func reset_passwords_function(ctx context.Context, params map[string]any) (string, int, string) {
+	var response struct {
+		apiStatus
+		RequestID string `json:"request_id"`
+	}
+	code, message := call_api(params, "/api/reset_passwords", POST, &response)
+	return response.RequestID, code, message
+}
+
+// wait_for_password_reset polls the status of a reset request until every account
+// has finished or the timeout expires. The results are keyed by account ID.
+func wait_for_password_reset(ctx context.Context, requestID string, timeout time.Duration) (map[string]securdenResetResult, int, string) {
+	deadline := time.Now().Add(timeout)
+	for {
+		var response struct {
+			apiStatus
+			Status  string                         `json:"status"`
+			Results map[string]securdenResetResult `json:"results"`
+		}
+		code, message := call_api(map[string]any{"request_id": requestID}, "/api/get_password_reset_status", GET, &response)
+		if code != 200 {
+			return nil, code, message
+		}
+		if strings.EqualFold(response.Status, "completed") {
+			return response.Results, 200, "Success"
+		}
+		if time.Now().After(deadline) {
+			return response.Results, 408, fmt.Sprintf("Password reset request %s did not complete within %s", requestID, timeout)
+		}
+		select {
+		case <-ctx.Done():
+			return response.Results, 408, fmt.Sprintf("Password reset request %s was cancelled: %v", requestID, ctx.Err())
+		case <-time.After(poll_interval):
+		}
+	}
+}
+
+type securdenPasswordPolicy struct {
+	ID                       int64  `json:"id"`
+	Name                     string `json:"name"`
+	Description              string `json:"description"`
+	MinLength                int64  `json:"min_length"`
+	MaxLength                int64  `json:"max_length"`
+	RequireUppercase         bool   `json:"require_uppercase"`
+	RequireLowercase         bool   `json:"require_lowercase"`
+	RequireDigits            bool   `json:"require_digits"`
+	RequireSpecialCharacters bool   `json:"require_special_characters"`
+	HistoryCount             int64  `json:"history_count"`
+	ExpiryDays               int64  `json:"expiry_days"`
+}
+
+func get_password_policy_function(ctx context.Context, policyID int64) (securdenPasswordPolicy, int, string) {
+	var response struct {
+		apiStatus
+		Policy securdenPasswordPolicy `json:"password_policy"`
+	}
+	code, message := call_api(map[string]any{"policy_id": policyID}, "/api/get_password_policy", GET, &response)
+	return response.Policy, code, message
+}
+
+func add_password_policy_function(ctx context.Context, params map[string]any) (int64, int, string) {
+	var response struct {
+		apiStatus
+		ID int64 `json:"ID"`
+	}
+	code, message := call_api(params, "/api/add_password_policy", POST, &response)
+	return response.ID, code, message
+}
+
+func edit_password_policy_function(ctx context.Context, params map[string]any) (int, string) {
+	var response struct {
+		apiStatus
+	}
+	return call_api(params, "/api/edit_password_policy", PUT, &response)
+}
+
+func delete_password_policy_function(ctx context.Context, params map[string]any) (int, string) {
+	var response struct {
+		apiStatus
+	}
+	return call_api(params, "/api/delete_password_policy", DELETE, &response)
+}
+
+// validate_password returns the rules of the policy that the password breaks.
+// History and expiry are enforced by the server and are not checked here.
+func validate_password(policy securdenPasswordPolicy, password string) []string {
+	var violations []string
+	length := int64(len([]rune(password)))
+	if policy.MinLength > 0 && length < policy.MinLength {
+		violations = append(violations, fmt.Sprintf("must be at least %d characters long", policy.MinLength))
+	}
+	if policy.MaxLength > 0 && length > policy.MaxLength {
+		violations = append(violations, fmt.Sprintf("must be at most %d characters long", policy.MaxLength))
+	}
+	var upper, lower, digit, special bool
+	for _, r := range password {
+		switch {
+		case unicode.IsUpper(r):
+			upper = true
+		case unicode.IsLower(r):
+			lower = true
+		case unicode.IsDigit(r):
+			digit = true
+		default:
+			special = true
+		}
+	}
+	if policy.RequireUppercase && !upper {
+		violations = append(violations, "must contain an uppercase letter")
+	}
+	if policy.RequireLowercase && !lower {
+		violations = append(violations, "must contain a lowercase letter")
+	}
+	if policy.RequireDigits && !digit {
+		violations = append(violations, "must contain a digit")
+	}
+	if policy.RequireSpecialCharacters && !special {
+		violations = append(violations, "must contain a special character")
+	}
+	return violations
+}
+
+// check_password_policy validates a password against the policy with the given
+// ID, adding an error for every rule it breaks. It returns false when the
+// password must not be sent to the server.
+func check_password_policy(ctx context.Context, policyID types.Int64, password types.String, diags *diag.Diagnostics) bool {
+	if policyID.IsNull() || policyID.IsUnknown() || password.IsNull() || password.IsUnknown() || password.ValueString() == "" {
+		return true
+	}
+	policy, code, message := get_password_policy_function(ctx, policyID.ValueInt64())
+	if code != 200 {
+		diags.AddError(fmt.Sprintf("%d - %s", code, message), "")
+		return false
+	}
+	violations := validate_password(policy, password.ValueString())
+	if len(violations) > 0 {
+		diags.AddError(
+			"Password Does Not Meet Policy",
+			fmt.Sprintf("The password does not satisfy password policy %q: it %s.", policy.Name, strings.Join(violations, ", ")),
+		)
+		return false
+	}
+	return true
+}
+
+// generate_password_function asks Securden for a password that satisfies the
+// given policy. A zero policyID uses the server's default policy.
+func generate_password_function(ctx context.Context, policyID int64) (string, int, string) {
+	var response struct {
+		apiStatus
+		Password string `json:"password"`
+	}
+	params := make(map[string]any)
+	if policyID != 0 {
+		params["policy_id"] = policyID
+	}
+	code, message := call_api(params, "/api/generate_password", POST, &response)
+	if code == 200 && response.Password == "" {
+		return "", 500, "The server returned an empty password"
+	}
+	return response.Password, code, message
+}
+
+// generate_password_locally builds a random password that satisfies the length and
+// character class rules of the policy, using crypto/rand.
+func generate_password_locally(policy securdenPasswordPolicy) (string, error) {
+	length := int64(generated_password_length)
+	if policy.MinLength > length {
+		length = policy.MinLength
+	}
+	if policy.MaxLength > 0 && policy.MaxLength < length {
+		length = policy.MaxLength
+	}
+	classes := []string{password_uppercase, password_lowercase, password_digits, password_special}
+	required := []bool{policy.RequireUppercase, policy.RequireLowercase, policy.RequireDigits, policy.RequireSpecialCharacters}
+	if length < 4 {
+		return "", fmt.Errorf("policy %q allows at most %d characters, too short to generate a password", policy.Name, length)
+	}
+
+	var password []byte
+	for i, class := range classes {
+		if required[i] {
+			c, err := random_char(class)
+			if err != nil {
+				return "", err
+			}
+			password = append(password, c)
+		}
+	}
+	all := strings.Join(classes, "")
+	for int64(len(password)) < length {
+		c, err := random_char(all)
+		if err != nil {
+			return "", err
+		}
+		password = append(password, c)
+	}
+	// Shuffle so the required characters are not always at the start.
+	for i := len(password) - 1; i > 0; i-- {
+		n, err := rand.Int(rand.Reader, big.NewInt(int64(i+1)))
+		if err != nil {
+			return "", err
+		}
+		j := n.Int64()
+		password[i], password[j] = password[j], password[i]
+	}
+	return string(password), nil
+}
+
+func random_char(charset string) (byte, error) {
+	n, err := rand.Int(rand.Reader, big.NewInt(int64(len(charset))))
+	if err != nil {
+		return 0, err
+	}
+	return charset[n.Int64()], nil
+}
+
+// generate_password returns a generated password for a new account. Securden is
+// asked first; servers without the generator endpoint fall back to local
+// generation against the referenced policy.
+func generate_password(ctx context.Context, policyID types.Int64) (string, int, string) {
+	password, code, message := generate_password_function(ctx, policyID.ValueInt64())
+	if code != 404 {
+		return password, code, message
+	}
+	var policy securdenPasswordPolicy
+	if !policyID.IsNull() && !policyID.IsUnknown() {
+		policy, code, message = get_password_policy_function(ctx, policyID.ValueInt64())
+		if code != 200 {
+			return "", code, message
+		}
+	}
+	password, err := generate_password_locally(policy)
+	if err != nil {
+		return "", 400, err.Error()
+	}
+	return password, 200, "Success"
+}
+
+type securdenSSHKey struct {
+	AccountID   int64  `json:"account_id"`
+	KeyType     string `json:"key_type"`
+	PublicKey   string `json:"public_key"`
+	PrivateKey  string `json:"private_key"`
+	Passphrase  string `json:"passphrase"`
+	Certificate string `json:"certificate"`
+	Fingerprint string `json:"fingerprint"`
+}
+
+// get_ssh_key_function retrieves the key pair stored in an SSH key account. The
+// fingerprint is computed from the public key when the server does not report it.
+func get_ssh_key_function(ctx context.Context, accountID int64) (securdenSSHKey, int, string) {
+	var response struct {
+		apiStatus
+		Key securdenSSHKey `json:"ssh_key"`
+	}
+	code, message := call_api(map[string]any{"account_id": accountID}, "/api/get_ssh_key", GET, &response)
+	if code != 200 {
+		return response.Key, code, message
+	}
+	if response.Key.Fingerprint == "" {
+		response.Key.Fingerprint = ssh_fingerprint(response.Key.PublicKey)
+	}
+	return response.Key, 200, "Success"
+}
+
+// ssh_fingerprint returns the OpenSSH SHA256 fingerprint (SHA256:<base64>) of a
+// public key in authorized_keys format, or an empty string if it cannot be parsed.
+func ssh_fingerprint(publicKey string) string {
+	fields := strings.Fields(publicKey)
+	if len(fields) < 2 {
+		return ""
+	}
+	blob, err := base64.StdEncoding.DecodeString(fields[1])
+	if err != nil {
+		return ""
+	}
+	sum := sha256.Sum256(blob)
+	return "SHA256:" + base64.RawStdEncoding.EncodeToString(sum[:])
+}
+
+// get_ssh_key_hosts_function lists the target hosts an SSH key account is
+// associated with.
+func get_ssh_key_hosts_function(ctx context.Context, accountID int64) ([]string, int, string) {
+	var response struct {
+		apiStatus
+		Hosts []string `json:"hosts"`
+	}
+	code, message := call_api(map[string]any{"account_id": accountID}, "/api/get_ssh_key_associations", GET, &response)
+	return response.Hosts, code, message
+}
+
+// associate_ssh_key_function deploys an SSH key to the given target hosts.
+func associate_ssh_key_function(ctx context.Context, params map[string]any) (int, string) {
+	var response struct {
+		apiStatus
+	}
+	return call_api(params, "/api/associate_ssh_key", POST, &response)
+}
+
+// dissociate_ssh_key_function removes an SSH key from the given target hosts.
+func dissociate_ssh_key_function(ctx context.Context, params map[string]any) (int, string) {
+	var response struct {
+		apiStatus
+	}
+	return call_api(params, "/api/dissociate_ssh_key", DELETE, &response)
+}
+
+type securdenAttachment struct {
+	ID   int64  `json:"id"`
+	Name string `json:"name"`
+	Size int64  `json:"size"`
+}
+
+// upload_attachment_function attaches a file to an account and returns the ID of
+// the new attachment.
+func upload_attachment_function(ctx context.Context, accountID int64, name string, content []byte) (int64, int, string) {
+	var response struct {
+		apiStatus
+		ID int64 `json:"ID"`
+	}
+	body, err := raise_multipart_request(map[string]any{"account_id": accountID}, "file", name, content, "/api/add_attachment")
+	if err != nil {
+		return 0, 500, fmt.Sprintf("Error in API call: %v", err)
+	}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return 0, 500, fmt.Sprintf("Failed to parse response: %v", err)
+	}
+	code, message := response.result()
+	return response.ID, code, message
+}
+
+// get_attachments_function lists the files attached to an account.
+func get_attachments_function(ctx context.Context, accountID int64) ([]securdenAttachment, int, string) {
+	var response struct {
+		apiStatus
+		Attachments []securdenAttachment `json:"attachments"`
+	}
+	code, message := call_api(map[string]any{"account_id": accountID}, "/api/get_attachments", GET, &response)
+	return response.Attachments, code, message
+}
+
+// find_attachment looks up an attachment of an account by file name. A 404 code is
+// returned when the account has no attachment with that name.
+func find_attachment(ctx context.Context, accountID int64, name string) (securdenAttachment, int, string) {
+	attachments, code, message := get_attachments_function(ctx, accountID)
+	if code != 200 {
+		return securdenAttachment{}, code, message
+	}
+	for _, attachment := range attachments {
+		if attachment.Name == name {
+			return attachment, 200, "Success"
+		}
+	}
+	return securdenAttachment{}, 404, fmt.Sprintf("Attachment %q not found on account %d", name, accountID)
+}
+
+// download_attachment_function returns the base64 encoded content of an attachment.
+func download_attachment_function(ctx context.Context, accountID, attachmentID int64) (string, int, string) {
+	var response struct {
+		apiStatus
+		Content string `json:"content"`
+	}
+	params := map[string]any{"account_id": accountID, "attachment_id": attachmentID}
+	code, message := call_api(params, "/api/get_attachment", GET, &response)
+	return response.Content, code, message
+}
+
+func delete_attachment_function(ctx context.Context, params map[string]any) (int, string) {
+	var response struct {
+		apiStatus
+	}
+	return call_api(params, "/api/delete_attachment", DELETE, &response)
+}
+
+// additional_fields_param merges the plain and sensitive additional fields into
+// the additional_fields request parameter. It returns nil when both are empty.
+func additional_fields_param(ctx context.Context, fields, sensitiveFields types.Map, diags *diag.Diagnostics) map[string]string {
+	plain := string_map_values(ctx, fields, diags)
+	sensitive := string_map_values(ctx, sensitiveFields, diags)
+	if len(plain) == 0 && len(sensitive) == 0 {
+		return nil
+	}
+	merged := make(map[string]string, len(plain)+len(sensitive))
+	for key, value := range plain {
+		merged[key] = value
+	}
+	for key, value := range sensitive {
+		merged[key] = value
+	}
+	return merged
+}
+
+// string_map_values converts a map of strings to a Go map. Null and unknown maps
+// give nil; the map must otherwise be known, as it is once the plan is applied.
+func string_map_values(ctx context.Context, value types.Map, diags *diag.Diagnostics) map[string]string {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+	values := make(map[string]string, len(value.Elements()))
+	diags.Append(value.ElementsAs(ctx, &values, false)...)
+	return values
+}
+
+// string_map_value converts a Go map to a map of strings, giving null for nil.
+func string_map_value(values map[string]string) types.Map {
+	if values == nil {
+		return types.MapNull(types.StringType)
+	}
+	elements := make(map[string]attr.Value, len(values))
+	for key, value := range values {
+		elements[key] = types.StringValue(value)
+	}
+	return types.MapValueMust(types.StringType, elements)
+}
+
+// parse_tags splits the comma separated tags reported by the server.
+func parse_tags(tags string) []string {
+	var values []string
+	for _, tag := range strings.Split(tags, ",") {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			values = append(values, tag)
+		}
+	}
+	return values
+}
+
+func tag_values(tags []types.String) []string {
+	values := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		if !tag.IsNull() && !tag.IsUnknown() {
+			values = append(values, tag.ValueString())
+		}
+	}
+	return values
+}
+
+// tag_set_values returns the known tags of a set of strings.
+func tag_set_values(tags types.Set) []string {
+	values := []string{}
+	for _, tag := range tags.Elements() {
+		if tag, ok := tag.(types.String); ok && !tag.IsNull() && !tag.IsUnknown() {
+			values = append(values, tag.ValueString())
+		}
+	}
+	return values
+}
+
+// tag_set_known reports whether a set of tags and all of its elements are known.
+func tag_set_known(tags types.Set) bool {
+	if tags.IsUnknown() {
+		return false
+	}
+	for _, tag := range tags.Elements() {
+		if tag.IsUnknown() {
+			return false
+		}
+	}
+	return true
+}
+
+// string_set_value converts a slice to a set of strings, giving null for nil.
+func string_set_value(values []string) types.Set {
+	if values == nil {
+		return types.SetNull(types.StringType)
+	}
+	elements := make([]attr.Value, 0, len(values))
+	for _, value := range values {
+		elements = append(elements, types.StringValue(value))
+	}
+	return types.SetValueMust(types.StringType, elements)
+}
+
+// with_default_tags adds the provider level default_tags to tags.
+func with_default_tags(tags []string) []string {
+	return append(append([]string(nil), tags...), SecurdenDefaultTags...)
+}
+
+// tags_param normalizes tags to the format the server stores: trimmed,
+// de-duplicated, sorted and comma separated.
+func tags_param(tags []string) string {
+	return strings.Join(normalize_tags(tags), ",")
+}
+
+func normalize_tags(tags []string) []string {
+	seen := make(map[string]bool, len(tags))
+	normalized := []string{}
+	for _, tag := range tags {
+		if tag = strings.TrimSpace(tag); tag != "" && !seen[tag] {
+			seen[tag] = true
+			normalized = append(normalized, tag)
+		}
+	}
+	sort.Strings(normalized)
+	return normalized
+}
+
+type securdenCheckout struct {
+	CheckoutID string `json:"checkout_id"`
+	Password   string `json:"password"`
+	ExpiresAt  string `json:"expires_at"`
+}
+
+// checkout_account_function checks out an exclusive-access account and returns
+// its password.
+func checkout_account_function(ctx context.Context, params map[string]any) (securdenCheckout, int, string) {
+	var response struct {
+		apiStatus
+		securdenCheckout
+	}
+	code, message := call_api(params, "/api/checkout_account", POST, &response)
+	return response.securdenCheckout, code, message
+}
+
+// checkin_account_function returns a checked out account so that others can use
+// it and the password can be reset.
+func checkin_account_function(ctx context.Context, params map[string]any) (int, string) {
+	var response struct {
+		apiStatus
+	}
+	return call_api(params, "/api/checkin_account", POST, &response)
+}
+
+// raise_access_request_function asks the approvers of a protected account for
+// access and returns the ID of the access request.
+func raise_access_request_function(ctx context.Context, params map[string]any) (string, int, string) {
+	var response struct {
+		apiStatus
+		RequestID string `json:"request_id"`
+	}
+	code, message := call_api(params, "/api/raise_access_request", POST, &response)
+	return response.RequestID, code, message
+}
+
+// wait_for_access_request polls an access request until it is approved, rejected
+// or the timeout expires. A 403 code is returned when the request was rejected.
+func wait_for_access_request(ctx context.Context, requestID string, timeout time.Duration) (int, string) {
+	deadline := time.Now().Add(timeout)
+	for {
+		var response struct {
+			apiStatus
+			Status string `json:"status"`
+		}
+		code, message := call_api(map[string]any{"request_id": requestID}, "/api/get_access_request_status", GET, &response)
+		if code != 200 {
+			return code, message
+		}
+		switch strings.ToLower(response.Status) {
+		case "approved":
+			return 200, "Success"
+		case "rejected", "denied", "expired":
+			return 403, fmt.Sprintf("Access request %s was %s", requestID, strings.ToLower(response.Status))
+		}
+		if time.Now().After(deadline) {
+			return 408, fmt.Sprintf("Access request %s was not approved within %s", requestID, timeout)
+		}
+		select {
+		case <-ctx.Done():
+			return 408, fmt.Sprintf("Access request %s was cancelled: %v", requestID, ctx.Err())
+		case <-time.After(poll_interval):
+		}
+	}
+}
+
+type securdenAuditEntry struct {
+	Timestamp string `json:"timestamp"`
+	AccountID int64  `json:"account_id"`
+	User      string `json:"user"`
+	Operation string `json:"operation"`
+	SourceIP  string `json:"source_ip"`
+	Reason    string `json:"reason"`
+}
+
+// get_audit_trail_function returns the audit entries of an account or of the
+// accounts in a folder, optionally limited to a time range.
+func get_audit_trail_function(ctx context.Context, params map[string]any) ([]securdenAuditEntry, int, string) {
+	var response struct {
+		apiStatus
+		AuditTrail []securdenAuditEntry `json:"audit_trail"`
+	}
+	code, message := call_api(params, "/api/get_audit_trail", GET, &response)
+	return response.AuditTrail, code, message
+}
+
+type securdenDeletedAccount struct {
+	ID           int64  `json:"id"`
+	AccountName  string `json:"account_name"`
+	AccountTitle string `json:"account_title"`
+	AccountType  string `json:"account_type"`
+	FolderID     int64  `json:"folder_id"`
+	DeletedBy    string `json:"deleted_by"`
+	DeletedAt    string `json:"deleted_at"`
+}
+
+// get_recycle_bin_function lists the soft-deleted accounts that can be restored.
+func get_recycle_bin_function(ctx context.Context, params map[string]any) ([]securdenDeletedAccount, int, string) {
+	var response struct {
+		apiStatus
+		Accounts []securdenDeletedAccount `json:"accounts"`
+	}
+	code, message := call_api(params, "/api/get_recycle_bin", GET, &response)
+	return response.Accounts, code, message
+}
+
+// restore_accounts_function restores soft-deleted accounts and returns the IDs
+// that were restored.
+func restore_accounts_function(ctx context.Context, params map[string]any) ([]int64, int, string) {
+	var response struct {
+		apiStatus
+		Restored []int64 `json:"IDs restored successfully"`
+	}
+	code, message := call_api(params, "/api/restore_accounts", PUT, &response)
+	return response.Restored, code, message
+}
+
+// check_deletion_protection reports whether a managed object may be deleted,
+// adding an error that explains how to proceed when deletion_protection is set.
+func check_deletion_protection(kind string, id types.Int64, protected types.Bool, diags *diag.Diagnostics) bool {
+	if !protected.ValueBool() {
+		return true
+	}
+	diags.AddError(
+		"Deletion Protection Enabled",
+		fmt.Sprintf("The %s %d has deletion_protection enabled. Set deletion_protection = false and apply before destroying or replacing it.", kind, id.ValueInt64()),
+	)
+	return false
+}
+
+type securdenConnection struct {
+	Host     string `json:"host"`
+	Port     int64  `json:"port"`
+	Protocol string `json:"protocol"`
+	Gateway  string `json:"gateway"`
+}
+
+// get_connection_function returns the endpoint Securden uses to broker remote
+// sessions for an account.
+func get_connection_function(ctx context.Context, accountID int64, reason string) (securdenConnection, int, string) {
+	var response struct {
+		apiStatus
+		Connection securdenConnection `json:"connection"`
+	}
+	params := map[string]any{"account_id": accountID, "reason": reason}
+	code, message := call_api(params, "/api/get_connection_details", GET, &response)
+	return response.Connection, code, message
+}
+
+// launch_session_function creates a one-time URL that opens a brokered remote
+// session for an account.
+func launch_session_function(ctx context.Context, params map[string]any) (string, int, string) {
+	var response struct {
+		apiStatus
+		SessionURL string `json:"session_url"`
+	}
+	code, message := call_api(params, "/api/launch_session", POST, &response)
+	return response.SessionURL, code, message
+}
+
+type securdenAccountType struct {
+	Name           string   `json:"name"`
+	RequiredFields []string `json:"required_fields"`
+}
+
+var account_types_cache struct {
+	sync.Mutex
+	serverURL string
+	types     []securdenAccountType
+	builtin   bool
+}
+
+func get_account_types_function(ctx context.Context) ([]securdenAccountType, int, string) {
+	var response struct {
+		apiStatus
+		AccountTypes []securdenAccountType `json:"account_types"`
+	}
+	code, message := call_api(map[string]any{}, "/api/get_account_types", GET, &response)
+	return response.AccountTypes, code, message
+}
+
+// account_types returns the account type catalog of the server, cached for the
+// lifetime of the provider. Servers without the catalog endpoint get the built-in
+// list of types with extra required fields, reported by builtin.
+func account_types(ctx context.Context) ([]securdenAccountType, bool, int, string) {
+	account_types_cache.Lock()
+	defer account_types_cache.Unlock()
+	if account_types_cache.types != nil && account_types_cache.serverURL == SecurdenServerURL {
+		return account_types_cache.types, account_types_cache.builtin, 200, "Success"
+	}
+	catalog, code, message := get_account_types_function(ctx)
+	builtin := false
+	if code == 404 {
+		catalog, builtin, code = builtin_account_types(), true, 200
+	}
+	if code != 200 {
+		return nil, false, code, message
+	}
+	sort.Slice(catalog, func(i, j int) bool { return catalog[i].Name < catalog[j].Name })
+	account_types_cache.serverURL = SecurdenServerURL
+	account_types_cache.types = catalog
+	account_types_cache.builtin = builtin
+	return catalog, builtin, 200, "Success"
+}
+
+func builtin_account_types() []securdenAccountType {
+	catalog := make([]securdenAccountType, 0, len(account_type_required_fields))
+	for name, fields := range account_type_required_fields {
+		catalog = append(catalog, securdenAccountType{Name: name, RequiredFields: fields})
+	}
+	return catalog
+}
+
+// required_account_fields returns the extra fields required by an account type
+// and whether the catalog lists the type.
+func required_account_fields(catalog []securdenAccountType, accountType string) ([]string, bool) {
+	for _, candidate := range catalog {
+		if candidate.Name == accountType {
+			return candidate.RequiredFields, true
+		}
+	}
+	return nil, false
+}
+
+// validate_account_type checks account_type against the catalog and reports the
+// required fields that are empty in fields. Unknown values and fields that are
+// not in fields are skipped, and so is the check when the catalog is unavailable.
+// The built-in list only holds the types with extra required fields, so types it
+// does not list are accepted rather than reported as unsupported.
+func validate_account_type(ctx context.Context, accountType string, fields map[string]types.String, diags *diag.Diagnostics) {
+	catalog, builtin, code, message := account_types(ctx)
+	if code != 200 {
+		tflog.Warn(ctx, "Unable to read the Securden account type catalog, skipping account type validation", map[string]any{"status_code": code, "message": message})
+		return
+	}
+	required, ok := required_account_fields(catalog, accountType)
+	if !ok && builtin {
+		return
+	}
+	if !ok {
+		names := make([]string, 0, len(catalog))
+		for _, candidate := range catalog {
+			names = append(names, candidate.Name)
+		}
+		diags.AddAttributeError(path.Root("account_type"), "Unsupported Account Type", fmt.Sprintf("%q is not an account type supported by the server. Supported types: %s.", accountType, strings.Join(names, ", ")))
+		return
+	}
+	for _, field := range required {
+		value, tracked := fields[field]
+		if !tracked || value.IsUnknown() {
+			continue
+		}
+		if value.ValueString() == "" {
+			diags.AddAttributeError(path.Root(field), "Missing Required Field", fmt.Sprintf("%s is required for %s accounts.", field, accountType))
+		}
+	}
+}
@@ -0,0 +1,277 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &PasswordPolicy{}
+var _ resource.ResourceWithImportState = &PasswordPolicy{}
+var _ resource.ResourceWithValidateConfig = &PasswordPolicy{}
+
+func password_policy() resource.Resource {
+	return &PasswordPolicy{}
+}
+
+type PasswordPolicy struct {
+	client *http.Client
+}
+
+type PasswordPolicyModel struct {
+	ID                       types.Int64  `tfsdk:"id"`
+	Name                     types.String `tfsdk:"name"`
+	Description              types.String `tfsdk:"description"`
+	MinLength                types.Int64  `tfsdk:"min_length"`
+	MaxLength                types.Int64  `tfsdk:"max_length"`
+	RequireUppercase         types.Bool   `tfsdk:"require_uppercase"`
+	RequireLowercase         types.Bool   `tfsdk:"require_lowercase"`
+	RequireDigits            types.Bool   `tfsdk:"require_digits"`
+	RequireSpecialCharacters types.Bool   `tfsdk:"require_special_characters"`
+	HistoryCount             types.Int64  `tfsdk:"history_count"`
+	ExpiryDays               types.Int64  `tfsdk:"expiry_days"`
+	Reason                   types.String `tfsdk:"reason"`
+}
+
+func (r *PasswordPolicy) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_password_policy"
+}
+
+func (r *PasswordPolicy) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a password policy in Securden.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Unique identifier of the password policy.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the password policy.",
+			},
+			"description": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Description of the password policy.",
+			},
+			"min_length": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(0),
+				MarkdownDescription: "Minimum number of characters. `0` means no minimum.",
+			},
+			"max_length": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(0),
+				MarkdownDescription: "Maximum number of characters. `0` means no maximum.",
+			},
+			"require_uppercase": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Whether passwords must contain an uppercase letter.",
+			},
+			"require_lowercase": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Whether passwords must contain a lowercase letter.",
+			},
+			"require_digits": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Whether passwords must contain a digit.",
+			},
+			"require_special_characters": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Whether passwords must contain a character that is not a letter or digit.",
+			},
+			"history_count": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(0),
+				MarkdownDescription: "Number of previous passwords that cannot be reused. `0` disables the check.",
+			},
+			"expiry_days": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(0),
+				MarkdownDescription: "Number of days after which passwords expire. `0` means passwords do not expire.",
+			},
+			"reason": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Reason recorded in the Securden audit trail for the changes Terraform makes to the password policy. Overrides the provider's `default_reason`.",
+			},
+		},
+	}
+}
+
+func (r *PasswordPolicy) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var policy PasswordPolicyModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &policy)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	for name, value := range map[string]types.Int64{
+		"min_length":    policy.MinLength,
+		"max_length":    policy.MaxLength,
+		"history_count": policy.HistoryCount,
+		"expiry_days":   policy.ExpiryDays,
+	} {
+		if !value.IsNull() && !value.IsUnknown() && value.ValueInt64() < 0 {
+			resp.Diagnostics.AddAttributeError(path.Root(name), "Invalid Password Policy", fmt.Sprintf("%s must not be negative.", name))
+		}
+	}
+	if policy.MinLength.IsNull() || policy.MinLength.IsUnknown() || policy.MaxLength.IsNull() || policy.MaxLength.IsUnknown() {
+		return
+	}
+	if policy.MaxLength.ValueInt64() > 0 && policy.MinLength.ValueInt64() > policy.MaxLength.ValueInt64() {
+		resp.Diagnostics.AddAttributeError(path.Root("max_length"), "Invalid Password Policy", "max_length must not be lower than min_length.")
+	}
+}
+
+func (r *PasswordPolicy) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*http.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+func (r *PasswordPolicy) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !check_resource_configured(r.client, &resp.Diagnostics) {
+		return
+	}
+	var policy PasswordPolicyModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &policy)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	id, code, message := add_password_policy_function(ctx, password_policy_params(policy))
+	if code != 200 {
+		resp.Diagnostics.AddError(fmt.Sprintf("%d - %s", code, message), "")
+		return
+	}
+	policy.ID = types.Int64Value(id)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &policy)...)
+}
+
+func (r *PasswordPolicy) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !check_resource_configured(r.client, &resp.Diagnostics) {
+		return
+	}
+	var policy PasswordPolicyModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &policy)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data, code, message := get_password_policy_function(ctx, policy.ID.ValueInt64())
+	if code == 404 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if code != 200 {
+		resp.Diagnostics.AddError(fmt.Sprintf("%d - %s", code, message), "")
+		return
+	}
+	policy.Name = types.StringValue(data.Name)
+	if data.Description != "" || !policy.Description.IsNull() {
+		policy.Description = types.StringValue(data.Description)
+	}
+	policy.MinLength = types.Int64Value(data.MinLength)
+	policy.MaxLength = types.Int64Value(data.MaxLength)
+	policy.RequireUppercase = types.BoolValue(data.RequireUppercase)
+	policy.RequireLowercase = types.BoolValue(data.RequireLowercase)
+	policy.RequireDigits = types.BoolValue(data.RequireDigits)
+	policy.RequireSpecialCharacters = types.BoolValue(data.RequireSpecialCharacters)
+	policy.HistoryCount = types.Int64Value(data.HistoryCount)
+	policy.ExpiryDays = types.Int64Value(data.ExpiryDays)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &policy)...)
+}
+
+func (r *PasswordPolicy) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !check_resource_configured(r.client, &resp.Diagnostics) {
+		return
+	}
+	var policy PasswordPolicyModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &policy)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	params := password_policy_params(policy)
+	setParam(params, "policy_id", policy.ID)
+	params["description"] = policy.Description.ValueString()
+	code, message := edit_password_policy_function(ctx, params)
+	if code != 200 {
+		resp.Diagnostics.AddError(fmt.Sprintf("%d - %s", code, message), "")
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &policy)...)
+}
+
+func (r *PasswordPolicy) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !check_resource_configured(r.client, &resp.Diagnostics) {
+		return
+	}
+	var policy PasswordPolicyModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &policy)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	params := make(map[string]any)
+	setParam(params, "policy_id", policy.ID)
+	setParam(params, "reason", policy.Reason)
+	code, message := delete_password_policy_function(ctx, params)
+	if code != 200 && code != 404 {
+		resp.Diagnostics.AddError(fmt.Sprintf("%d - %s", code, message), "")
+	}
+}
+
+func (r *PasswordPolicy) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("Expected a numeric password policy ID, got: %q", req.ID))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+func password_policy_params(policy PasswordPolicyModel) map[string]any {
+	params := make(map[string]any)
+	setParam(params, "policy_name", policy.Name)
+	setParam(params, "description", policy.Description)
+	setParam(params, "min_length", policy.MinLength)
+	setParam(params, "max_length", policy.MaxLength)
+	setParam(params, "require_uppercase", policy.RequireUppercase)
+	setParam(params, "require_lowercase", policy.RequireLowercase)
+	setParam(params, "require_digits", policy.RequireDigits)
+	setParam(params, "require_special_characters", policy.RequireSpecialCharacters)
+	setParam(params, "history_count", policy.HistoryCount)
+	setParam(params, "expiry_days", policy.ExpiryDays)
+	setParam(params, "reason", policy.Reason)
+	return params
+}
@@ -0,0 +1,263 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &PasswordRotation{}
+var _ resource.ResourceWithModifyPlan = &PasswordRotation{}
+
+func password_rotation() resource.Resource {
+	return &PasswordRotation{}
+}
+
+type PasswordRotation struct {
+	client *http.Client
+}
+
+type PasswordRotationModel struct {
+	ID              types.String      `tfsdk:"id"`
+	AccountIDs      []types.Int64     `tfsdk:"account_ids"`
+	RotationTrigger types.String      `tfsdk:"rotation_trigger"`
+	Reason          types.String      `tfsdk:"reason"`
+	Timeout         types.Int64       `tfsdk:"timeout"`
+	Results         map[string]string `tfsdk:"results"`
+}
+
+func (r *PasswordRotation) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_account_password_rotation"
+}
+
+func (r *PasswordRotation) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Triggers a remote password reset for Securden accounts. The passwords are rotated when the resource is created and whenever `account_ids` or `rotation_trigger` change. Destroying the resource does not change any password.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "ID of the last password reset request.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"account_ids": schema.SetAttribute{
+				ElementType:         types.Int64Type,
+				Required:            true,
+				MarkdownDescription: "IDs of the accounts whose passwords are rotated.",
+			},
+			"rotation_trigger": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Arbitrary value; changing it rotates the passwords again, e.g. a date or `timestamp()` kept in a variable.",
+			},
+			"reason": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Reason recorded in the Securden audit trail for the rotation.",
+			},
+			"timeout": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(default_rotation_timeout),
+				MarkdownDescription: fmt.Sprintf("Seconds to wait for the reset to complete. Defaults to %d.", default_rotation_timeout),
+			},
+			"results": schema.MapAttribute{
+				ElementType:         types.StringType,
+				Computed:            true,
+				MarkdownDescription: "Outcome of the last rotation keyed by account ID: `success` or `failed: <reason>`.",
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *PasswordRotation) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*http.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+func (r *PasswordRotation) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !check_resource_configured(r.client, &resp.Diagnostics) {
+		return
+	}
+	var rotation PasswordRotationModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &rotation)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !rotate_passwords(ctx, &rotation, &resp.Diagnostics) {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &rotation)...)
+}
+
+func (r *PasswordRotation) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !check_resource_configured(r.client, &resp.Diagnostics) {
+		return
+	}
+	var rotation PasswordRotationModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &rotation)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &rotation)...)
+}
+
+func (r *PasswordRotation) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !check_resource_configured(r.client, &resp.Diagnostics) {
+		return
+	}
+	var rotation PasswordRotationModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &rotation)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var state PasswordRotationModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !rotation_needed(state, rotation) {
+		rotation.ID = state.ID
+		rotation.Results = state.Results
+		resp.Diagnostics.Append(resp.State.Set(ctx, &rotation)...)
+		return
+	}
+	if !rotate_passwords(ctx, &rotation, &resp.Diagnostics) {
+		return
+	}
+	if resp.Diagnostics.HasError() {
+		// An update that fails is not tainted, so keep the previous trigger and
+		// accounts in state to rotate the passwords again on the next apply.
+		rotation.RotationTrigger = state.RotationTrigger
+		rotation.AccountIDs = state.AccountIDs
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &rotation)...)
+}
+
+// ModifyPlan marks id and results as unknown when the plan rotates the passwords
+// again; otherwise they keep their state values.
+func (r *PasswordRotation) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+	var plan, state PasswordRotationModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	var trigger types.String
+	var accountIDs types.Set
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("rotation_trigger"), &trigger)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("account_ids"), &accountIDs)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !trigger.IsUnknown() && !accountIDs.IsUnknown() {
+		plan.RotationTrigger = trigger
+		resp.Diagnostics.Append(accountIDs.ElementsAs(ctx, &plan.AccountIDs, false)...)
+		if resp.Diagnostics.HasError() || !rotation_needed(state, plan) {
+			return
+		}
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("id"), types.StringUnknown())...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("results"), types.MapUnknown(types.StringType))...)
+}
+
+func (r *PasswordRotation) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !check_resource_configured(r.client, &resp.Diagnostics) {
+		return
+	}
+	// A rotation is a one-off action; there is nothing to remove in Securden.
+}
+
+// rotation_needed reports whether the plan changes the accounts or the trigger.
+// Changes to reason or timeout alone do not rotate the passwords again.
+func rotation_needed(state, plan PasswordRotationModel) bool {
+	if !state.RotationTrigger.Equal(plan.RotationTrigger) || len(state.AccountIDs) != len(plan.AccountIDs) {
+		return true
+	}
+	ids := make(map[int64]bool, len(state.AccountIDs))
+	for _, id := range state.AccountIDs {
+		ids[id.ValueInt64()] = true
+	}
+	for _, id := range plan.AccountIDs {
+		if !ids[id.ValueInt64()] {
+			return true
+		}
+	}
+	return false
+}
+
+// rotate_passwords raises the reset request, waits for it and records the per
+// account results. Accounts that failed are reported as an error, which taints
+// a new resource; Update keeps the previous trigger instead. Either way the
+// rotation is retried on the next apply. It returns false when the request could
+// not be raised and there is nothing to save in state.
+func rotate_passwords(ctx context.Context, rotation *PasswordRotationModel, diags *diag.Diagnostics) bool {
+	params := make(map[string]any)
+	params["account_ids"] = rotation.AccountIDs
+	setParam(params, "reason", rotation.Reason)
+	requestID, code, message := reset_passwords_function(ctx, params)
+	if code != 200 {
+		diags.AddError(fmt.Sprintf("%d - %s", code, message), "")
+		return false
+	}
+	rotation.ID = types.StringValue(requestID)
+
+	results, code, message := wait_for_password_reset(ctx, requestID, time.Duration(rotation.Timeout.ValueInt64())*time.Second)
+	rotation.Results = make(map[string]string, len(rotation.AccountIDs))
+	var failed []string
+	for _, id := range rotation.AccountIDs {
+		key := strconv.FormatInt(id.ValueInt64(), 10)
+		result, ok := results[key]
+		switch {
+		case ok && strings.EqualFold(result.Status, "success"):
+			rotation.Results[key] = "success"
+		case ok && result.Message != "":
+			rotation.Results[key] = "failed: " + result.Message
+		case ok:
+			rotation.Results[key] = "failed: " + result.Status
+		default:
+			rotation.Results[key] = "failed: no result reported"
+		}
+		if rotation.Results[key] != "success" {
+			failed = append(failed, fmt.Sprintf("%s (%s)", key, strings.TrimPrefix(rotation.Results[key], "failed: ")))
+		}
+	}
+	if code != 200 {
+		diags.AddError(fmt.Sprintf("%d - %s", code, message), "")
+		return true
+	}
+	if len(failed) > 0 {
+		sort.Strings(failed)
+		diags.AddError(
+			"Password Rotation Failed",
+			fmt.Sprintf("Password reset request %s failed for %d account(s): %s", requestID, len(failed), strings.Join(failed, ", ")),
+		)
+	}
+	return true
+}
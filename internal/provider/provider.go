@@ -2,17 +2,25 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 var _ provider.Provider = &securdenProvider{}
 var _ provider.ProviderWithFunctions = &securdenProvider{}
+var _ provider.ProviderWithEphemeralResources = &securdenProvider{}
 
 type securdenProvider struct {
 	version string
@@ -22,12 +30,24 @@ var SecurdenAuthToken string
 var SecurdenServerURL string
 var SecurdenOrg string
 var SecurdenCertificate string
+var SecurdenDefaultTags []string
+var SecurdenDefaultReason string
 var PluginVersion string
 
 type securdenProviderModel struct {
-	ServerURL   types.String `tfsdk:"server_url"`
-	AuthToken   types.String `tfsdk:"authtoken"`
-	Certificate types.String `tfsdk:"certificate"`
+	ServerURL        types.String `tfsdk:"server_url"`
+	AuthToken        types.String `tfsdk:"authtoken"`
+	Certificate      types.String `tfsdk:"certificate"`
+	SkipPreflight    types.Bool   `tfsdk:"skip_preflight"`
+	PreflightTimeout types.Int64  `tfsdk:"preflight_timeout"`
+	DefaultTags      types.Set    `tfsdk:"default_tags"`
+	DefaultReason    types.String `tfsdk:"default_reason"`
+}
+
+// PreflightInfo holds the details reported by the server during the preflight check.
+type PreflightInfo struct {
+	ServerVersion string
+	Scopes        []string
 }
 
 func (p *securdenProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -40,7 +60,7 @@ func (p *securdenProvider) Schema(_ context.Context, _ provider.SchemaRequest, r
 		Attributes: map[string]schema.Attribute{
 			"server_url": schema.StringAttribute{
 				Required:            true,
-				MarkdownDescription: "Securden Server URL. Example: https://company.securden.com:5959",
+				MarkdownDescription: "Securden Server URL. Example: https://company.securden.com:5959, https://company.securden.com or https://host/securden",
 			},
 			"authtoken": schema.StringAttribute{
 				Required:            true,
@@ -51,6 +71,23 @@ func (p *securdenProvider) Schema(_ context.Context, _ provider.SchemaRequest, r
 				Optional:            true,
 				MarkdownDescription: "Securden Server SSL Certificate",
 			},
+			"skip_preflight": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Skips the connectivity and auth token check performed when the provider is configured.",
+			},
+			"preflight_timeout": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Timeout in seconds for the preflight check. Defaults to 30.",
+			},
+			"default_tags": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Tags added to every account created or updated by the provider, in addition to the account's own `tags`.",
+			},
+			"default_reason": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Reason sent with every request so that Terraform activity is explained in the Securden audit trail. `{workspace}` and `{run_id}` are replaced with the Terraform workspace (`TF_WORKSPACE` or `TFC_WORKSPACE_NAME`) and the HCP Terraform run ID (`TFC_RUN_ID`).",
+			},
 		},
 	}
 }
@@ -59,7 +96,21 @@ func (p *securdenProvider) Configure(ctx context.Context, req provider.Configure
 	var config securdenProviderModel
 	diags := req.Config.Get(ctx, &config)
 	resp.Diagnostics.Append(diags...)
-	SecurdenServerURL = config.ServerURL.ValueString()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if config.ServerURL.IsUnknown() || config.AuthToken.IsUnknown() || config.Certificate.IsUnknown() || config.DefaultTags.IsUnknown() || config.DefaultReason.IsUnknown() ||
+		config.SkipPreflight.IsUnknown() || config.PreflightTimeout.IsUnknown() {
+		// Values derived from other resources are only known after apply. Leave the
+		// provider unconfigured so data sources can report it rather than failing
+		// URL validation on an empty string.
+		if req.ClientCapabilities.DeferralAllowed {
+			resp.Deferred = &provider.Deferred{Reason: provider.DeferredReasonProviderConfigUnknown}
+		}
+		tflog.Info(ctx, "Securden provider configuration contains unknown values, deferring configuration")
+		return
+	}
+	SecurdenServerURL = normalizeServerURL(config.ServerURL.ValueString())
 	isValidURL := isValidURL(SecurdenServerURL)
 	if !isValidURL {
 		resp.Diagnostics.AddError("Invalid Server URL", "The provided server URL is not valid.")
@@ -73,16 +124,95 @@ func (p *securdenProvider) Configure(ctx context.Context, req provider.Configure
 			return
 		}
 	}
-	if !isServerReachable(SecurdenServerURL) {
-		resp.Diagnostics.AddError("Server Unreachable", "The provided server URL is not reachable.")
-		return
-	}
 	SecurdenAuthToken = config.AuthToken.ValueString()
 	PluginVersion = p.version
+	SecurdenDefaultReason = render_reason(config.DefaultReason.ValueString())
+	SecurdenDefaultTags = nil
+	resp.Diagnostics.Append(config.DefaultTags.ElementsAs(ctx, &SecurdenDefaultTags, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Requests build their own client with get_client, so the value handed to data
+	// sources and resources only marks the provider as configured.
+	client := &http.Client{}
+	resp.DataSourceData = client
+	resp.ResourceData = client
+	resp.EphemeralResourceData = client
+
+	if config.SkipPreflight.ValueBool() {
+		return
+	}
+	timeout := default_preflight_timeout
+	if !config.PreflightTimeout.IsNull() {
+		if config.PreflightTimeout.ValueInt64() < 1 {
+			resp.Diagnostics.AddError("Invalid Preflight Timeout", "preflight_timeout must be at least 1 second.")
+			return
+		}
+		timeout = time.Duration(config.PreflightTimeout.ValueInt64()) * time.Second
+	}
+	info, code, message := preflight_check(ctx, timeout)
+	if code == 401 || code == 403 {
+		resp.Diagnostics.AddError("Invalid Auth Token", fmt.Sprintf("%d - %s", code, message))
+		return
+	}
+	if code != 200 {
+		resp.Diagnostics.AddError("Server Unreachable", fmt.Sprintf("%d - %s", code, message))
+		return
+	}
+	tflog.Info(ctx, "Securden preflight check succeeded", map[string]any{
+		"server_version": info.ServerVersion,
+		"token_scopes":   strings.Join(info.Scopes, ","),
+	})
+}
+
+// check_configured reports whether the provider has been configured. When it has
+// not, the read is deferred if Terraform supports it, otherwise an error is added.
+func check_configured(client *http.Client, req datasource.ReadRequest, resp *datasource.ReadResponse) bool {
+	if client != nil {
+		return true
+	}
+	if req.ClientCapabilities.DeferralAllowed {
+		resp.Deferred = &datasource.Deferred{Reason: datasource.DeferredReasonProviderConfigUnknown}
+		return false
+	}
+	resp.Diagnostics.AddError(
+		"Provider Not Configured Yet",
+		"The securden provider configuration depends on values that are not known until apply, so this data source cannot be read yet. "+
+			"Run the apply that creates those values first (for example with -target), or use a Terraform version that supports deferred actions.",
+	)
+	return false
+}
+
+// check_resource_configured reports whether the provider has been configured and
+// adds an error when it has not, so that resources do not send requests to an
+// empty server URL.
+func check_resource_configured(client *http.Client, diags *diag.Diagnostics) bool {
+	if client != nil {
+		return true
+	}
+	diags.AddError(
+		"Provider Not Configured Yet",
+		"The securden provider configuration depends on values that are not known until apply, so this resource cannot be managed yet. "+
+			"Run the apply that creates those values first, for example with -target.",
+	)
+	return false
 }
 
 func (p *securdenProvider) Resources(_ context.Context) []func() resource.Resource {
-	return []func() resource.Resource{}
+	return []func() resource.Resource{
+		account_resource,
+		account_attachment,
+		folder_resource,
+		account_share,
+		folder_share,
+		user_resource,
+		user_group_resource,
+		password_rotation,
+		restore_accounts,
+		password_policy,
+		ssh_key_association,
+	}
 }
 
 func (p *securdenProvider) DataSources(_ context.Context) []func() datasource.DataSource {
@@ -92,6 +222,24 @@ func (p *securdenProvider) DataSources(_ context.Context) []func() datasource.Da
 		add_account,
 		edit_account,
 		delete_accounts,
+		recycle_bin,
+		folder,
+		folders,
+		user,
+		user_group,
+		ssh_key,
+		attachment,
+		account_audit,
+		connection,
+		account_types_data_source,
+	}
+}
+
+func (p *securdenProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		account_password,
+		account_checkout,
+		connection_session,
 	}
 }
 
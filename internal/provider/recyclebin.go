@@ -0,0 +1,141 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &RecycleBin{}
+
+func recycle_bin() datasource.DataSource {
+	return &RecycleBin{}
+}
+
+type RecycleBin struct {
+	client *http.Client
+}
+
+type RecycleBinModel struct {
+	AccountType types.String          `tfsdk:"account_type"`
+	FolderID    types.Int64           `tfsdk:"folder_id"`
+	Accounts    []DeletedAccountModel `tfsdk:"accounts"`
+}
+
+type DeletedAccountModel struct {
+	ID           types.Int64  `tfsdk:"id"`
+	AccountName  types.String `tfsdk:"account_name"`
+	AccountTitle types.String `tfsdk:"account_title"`
+	AccountType  types.String `tfsdk:"account_type"`
+	FolderID     types.Int64  `tfsdk:"folder_id"`
+	DeletedBy    types.String `tfsdk:"deleted_by"`
+	DeletedAt    types.String `tfsdk:"deleted_at"`
+}
+
+func (d *RecycleBin) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_recycle_bin"
+}
+
+func (d *RecycleBin) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the soft-deleted accounts in the Securden recycle bin.",
+
+		Attributes: map[string]schema.Attribute{
+			"account_type": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return accounts of this type.",
+			},
+			"folder_id": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Only return accounts that were deleted from this folder.",
+			},
+			"accounts": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The soft-deleted accounts, in the order returned by the server.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Unique identifier of the account.",
+						},
+						"account_name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name associated with the account.",
+						},
+						"account_title": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The title associated with the account.",
+						},
+						"account_type": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Specifies the type or category of the account.",
+						},
+						"folder_id": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The ID of the folder the account was deleted from.",
+						},
+						"deleted_by": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The user who deleted the account.",
+						},
+						"deleted_at": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Time at which the account was deleted.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *RecycleBin) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*http.Client)
+	if !ok {
+		resp.Diagnostics.AddWarning(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+func (d *RecycleBin) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if !check_configured(d.client, req, resp) {
+		return
+	}
+	var bin RecycleBinModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &bin)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	params := make(map[string]any)
+	setParam(params, "account_type", bin.AccountType)
+	setParam(params, "folder_id", bin.FolderID)
+	accounts, code, message := get_recycle_bin_function(ctx, params)
+	if code != 200 {
+		resp.Diagnostics.AddError(fmt.Sprintf("%d - %s", code, message), "")
+		return
+	}
+	bin.Accounts = make([]DeletedAccountModel, 0, len(accounts))
+	for _, account := range accounts {
+		bin.Accounts = append(bin.Accounts, DeletedAccountModel{
+			ID:           types.Int64Value(account.ID),
+			AccountName:  types.StringValue(account.AccountName),
+			AccountTitle: types.StringValue(account.AccountTitle),
+			AccountType:  types.StringValue(account.AccountType),
+			FolderID:     types.Int64Value(account.FolderID),
+			DeletedBy:    types.StringValue(account.DeletedBy),
+			DeletedAt:    types.StringValue(account.DeletedAt),
+		})
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &bin)...)
+}
@@ -0,0 +1,179 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &RestoreAccounts{}
+
+func restore_accounts() resource.Resource {
+	return &RestoreAccounts{}
+}
+
+type RestoreAccounts struct {
+	client *http.Client
+}
+
+type RestoreAccountsModel struct {
+	ID               types.String  `tfsdk:"id"`
+	AccountIDs       []types.Int64 `tfsdk:"account_ids"`
+	Reason           types.String  `tfsdk:"reason"`
+	Message          types.String  `tfsdk:"message"`
+	RestoredAccounts []types.Int64 `tfsdk:"restored_accounts"`
+}
+
+func (r *RestoreAccounts) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_restore_accounts"
+}
+
+func (r *RestoreAccounts) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Restores soft-deleted accounts from the Securden recycle bin. The accounts are restored when the resource is created and again whenever `account_ids` change. Destroying the resource does not delete the accounts.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Comma separated IDs of the accounts that were restored.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"account_ids": schema.SetAttribute{
+				ElementType:         types.Int64Type,
+				Required:            true,
+				MarkdownDescription: "IDs of the accounts to be restored. Changing them restores the new set of accounts.",
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			"reason": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Reason recorded in the Securden audit trail for the restore.",
+			},
+			"message": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Response message indicating the result of the restore operation.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"restored_accounts": schema.ListAttribute{
+				ElementType:         types.Int64Type,
+				Computed:            true,
+				MarkdownDescription: "IDs of the accounts that were restored.",
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *RestoreAccounts) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*http.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+func (r *RestoreAccounts) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !check_resource_configured(r.client, &resp.Diagnostics) {
+		return
+	}
+	var restore RestoreAccountsModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &restore)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !restore_account_ids(ctx, &restore, &resp.Diagnostics) {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &restore)...)
+}
+
+func (r *RestoreAccounts) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !check_resource_configured(r.client, &resp.Diagnostics) {
+		return
+	}
+	var restore RestoreAccountsModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &restore)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &restore)...)
+}
+
+func (r *RestoreAccounts) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !check_resource_configured(r.client, &resp.Diagnostics) {
+		return
+	}
+	// Only reason can change in place; the accounts are not restored again.
+	var restore RestoreAccountsModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &restore)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &restore)...)
+}
+
+func (r *RestoreAccounts) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !check_resource_configured(r.client, &resp.Diagnostics) {
+		return
+	}
+	// A restore is a one-off action; the restored accounts are left in place.
+}
+
+// restore_account_ids restores the accounts of restore and records the result.
+// Accounts that the server did not restore are reported as an error.
+func restore_account_ids(ctx context.Context, restore *RestoreAccountsModel, diags *diag.Diagnostics) bool {
+	params := make(map[string]any)
+	params["account_ids"] = restore.AccountIDs
+	setParam(params, "reason", restore.Reason)
+	restored, code, message := restore_accounts_function(ctx, params)
+	if code != 200 {
+		diags.AddError(fmt.Sprintf("%d - %s", code, message), "")
+		return false
+	}
+	var missing []string
+	for _, id := range restore.AccountIDs {
+		if !slices.Contains(restored, id.ValueInt64()) {
+			missing = append(missing, strconv.FormatInt(id.ValueInt64(), 10))
+		}
+	}
+	if len(missing) > 0 {
+		diags.AddError("Accounts Not Restored", fmt.Sprintf("The following accounts were not restored: %s. %s", strings.Join(missing, ", "), message))
+		return false
+	}
+	slices.Sort(restored)
+	ids := make([]string, 0, len(restored))
+	restore.RestoredAccounts = make([]types.Int64, 0, len(restored))
+	for _, id := range restored {
+		ids = append(ids, strconv.FormatInt(id, 10))
+		restore.RestoredAccounts = append(restore.RestoredAccounts, types.Int64Value(id))
+	}
+	restore.ID = types.StringValue(strings.Join(ids, ","))
+	restore.Message = types.StringValue(message)
+	return true
+}
@@ -0,0 +1,247 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &Share{}
+var _ resource.ResourceWithImportState = &Share{}
+var _ resource.ResourceWithValidateConfig = &Share{}
+
+func account_share() resource.Resource {
+	return &Share{kind: "account"}
+}
+
+func folder_share() resource.Resource {
+	return &Share{kind: "folder"}
+}
+
+// Share shares an account or a folder, depending on kind, with a user or user
+// group. The shared object is identified by the <kind>_id attribute.
+type Share struct {
+	client *http.Client
+	kind   string
+}
+
+type ShareModel struct {
+	ID          types.String
+	TargetID    types.Int64
+	UserID      types.Int64
+	UserGroupID types.Int64
+	Permission  types.String
+	Reason      types.String
+}
+
+type shareAttributes interface {
+	GetAttribute(ctx context.Context, path path.Path, target interface{}) diag.Diagnostics
+}
+
+func (r *Share) target_attribute() string {
+	return r.kind + "_id"
+}
+
+// get_share reads the share attributes one by one, as the name of the target
+// attribute depends on kind.
+func (r *Share) get_share(ctx context.Context, data shareAttributes, diags *diag.Diagnostics) ShareModel {
+	var share ShareModel
+	diags.Append(data.GetAttribute(ctx, path.Root("id"), &share.ID)...)
+	diags.Append(data.GetAttribute(ctx, path.Root(r.target_attribute()), &share.TargetID)...)
+	diags.Append(data.GetAttribute(ctx, path.Root("user_id"), &share.UserID)...)
+	diags.Append(data.GetAttribute(ctx, path.Root("user_group_id"), &share.UserGroupID)...)
+	diags.Append(data.GetAttribute(ctx, path.Root("permission"), &share.Permission)...)
+	diags.Append(data.GetAttribute(ctx, path.Root("reason"), &share.Reason)...)
+	return share
+}
+
+func (r *Share) set_share(ctx context.Context, state *tfsdk.State, share ShareModel, diags *diag.Diagnostics) {
+	diags.Append(state.SetAttribute(ctx, path.Root("id"), share.ID)...)
+	diags.Append(state.SetAttribute(ctx, path.Root(r.target_attribute()), share.TargetID)...)
+	diags.Append(state.SetAttribute(ctx, path.Root("user_id"), share.UserID)...)
+	diags.Append(state.SetAttribute(ctx, path.Root("user_group_id"), share.UserGroupID)...)
+	diags.Append(state.SetAttribute(ctx, path.Root("permission"), share.Permission)...)
+	diags.Append(state.SetAttribute(ctx, path.Root("reason"), share.Reason)...)
+}
+
+func (r *Share) share_params(share ShareModel) map[string]any {
+	params := make(map[string]any)
+	setParam(params, r.target_attribute(), share.TargetID)
+	setParam(params, "user_id", share.UserID)
+	setParam(params, "user_group_id", share.UserGroupID)
+	setParam(params, "reason", share.Reason)
+	return params
+}
+
+func (r *Share) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.kind + "_share"
+}
+
+func (r *Share) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: fmt.Sprintf("Shares a Securden %s with a user or user group.", r.kind),
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: fmt.Sprintf("Identifier of the share in the form `<%[1]s>:user:<user_id>` or `<%[1]s>:user_group:<user_group_id>`.", r.target_attribute()),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			r.target_attribute(): schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: fmt.Sprintf("The ID of the %s to share.", r.kind),
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"user_id": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: fmt.Sprintf("The ID of the user to share the %s with. Conflicts with `user_group_id`.", r.kind),
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"user_group_id": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: fmt.Sprintf("The ID of the user group to share the %s with. Conflicts with `user_id`.", r.kind),
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"permission": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The permission granted: `view`, `modify` or `manage`.",
+			},
+			"reason": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: fmt.Sprintf("Reason recorded in the Securden audit trail for the changes Terraform makes to the share of the %s. Overrides the provider's `default_reason`.", r.kind),
+			},
+		},
+	}
+}
+
+func (r *Share) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	share := r.get_share(ctx, req.Config, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	validate_share_config(share.UserID, share.UserGroupID, share.Permission, &resp.Diagnostics)
+}
+
+func (r *Share) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*http.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+func (r *Share) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !check_resource_configured(r.client, &resp.Diagnostics) {
+		return
+	}
+	share := r.get_share(ctx, req.Plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	params := r.share_params(share)
+	setParam(params, "permission", share.Permission)
+	code, message := share_function(ctx, r.kind, params)
+	if code != 200 {
+		resp.Diagnostics.AddError(fmt.Sprintf("%d - %s", code, message), "")
+		return
+	}
+	share.ID = types.StringValue(share_id(share.TargetID.ValueInt64(), share.UserID, share.UserGroupID))
+	r.set_share(ctx, &resp.State, share, &resp.Diagnostics)
+}
+
+func (r *Share) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !check_resource_configured(r.client, &resp.Diagnostics) {
+		return
+	}
+	share := r.get_share(ctx, req.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	shares, code, message := get_shares_function(ctx, r.kind, share.TargetID.ValueInt64())
+	if code == 404 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if code != 200 {
+		resp.Diagnostics.AddError(fmt.Sprintf("%d - %s", code, message), "")
+		return
+	}
+	current, found := find_share(shares, share.UserID, share.UserGroupID)
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	share.Permission = types.StringValue(strings.ToLower(current.Permission))
+	share.ID = types.StringValue(share_id(share.TargetID.ValueInt64(), share.UserID, share.UserGroupID))
+	r.set_share(ctx, &resp.State, share, &resp.Diagnostics)
+}
+
+func (r *Share) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !check_resource_configured(r.client, &resp.Diagnostics) {
+		return
+	}
+	share := r.get_share(ctx, req.Plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	params := r.share_params(share)
+	setParam(params, "permission", share.Permission)
+	code, message := share_function(ctx, r.kind, params)
+	if code != 200 {
+		resp.Diagnostics.AddError(fmt.Sprintf("%d - %s", code, message), "")
+		return
+	}
+	r.set_share(ctx, &resp.State, share, &resp.Diagnostics)
+}
+
+func (r *Share) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !check_resource_configured(r.client, &resp.Diagnostics) {
+		return
+	}
+	share := r.get_share(ctx, req.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	code, message := unshare_function(ctx, r.kind, r.share_params(share))
+	if code != 200 && code != 404 {
+		resp.Diagnostics.AddError(fmt.Sprintf("%d - %s", code, message), "")
+	}
+}
+
+func (r *Share) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	targetID, userID, userGroupID, err := parse_share_id(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("Expected <%[1]s>:user:<user_id> or <%[1]s>:user_group:<user_group_id>, got: %[2]q", r.target_attribute(), req.ID))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(r.target_attribute()), targetID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_id"), userID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_group_id"), userGroupID)...)
+}
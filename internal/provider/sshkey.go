@@ -0,0 +1,112 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &SSHKey{}
+
+func ssh_key() datasource.DataSource {
+	return &SSHKey{}
+}
+
+type SSHKey struct {
+	client *http.Client
+}
+
+type SSHKeyModel struct {
+	AccountID   types.Int64  `tfsdk:"account_id"`
+	KeyType     types.String `tfsdk:"key_type"`
+	PublicKey   types.String `tfsdk:"public_key"`
+	PrivateKey  types.String `tfsdk:"private_key"`
+	Passphrase  types.String `tfsdk:"passphrase"`
+	Certificate types.String `tfsdk:"certificate"`
+	Fingerprint types.String `tfsdk:"fingerprint"`
+}
+
+func (d *SSHKey) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ssh_key"
+}
+
+func (d *SSHKey) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Retrieves the key pair stored in a Securden SSH key account.",
+
+		Attributes: map[string]schema.Attribute{
+			"account_id": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "Unique identifier of the SSH key account.",
+			},
+			"key_type": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Algorithm of the key, e.g. `ssh-ed25519` or `ssh-rsa`.",
+			},
+			"public_key": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The public key in OpenSSH authorized_keys format.",
+			},
+			"private_key": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The private key in PEM or OpenSSH format.",
+			},
+			"passphrase": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The passphrase protecting the private key, if any.",
+			},
+			"certificate": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The SSH certificate signed for the key, if any.",
+			},
+			"fingerprint": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "SHA256 fingerprint of the public key, e.g. `SHA256:nThbg6kXUpJWGl7E1IGOCspRomTxdCARLviKw6E5SY8`.",
+			},
+		},
+	}
+}
+
+func (d *SSHKey) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*http.Client)
+	if !ok {
+		resp.Diagnostics.AddWarning(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+func (d *SSHKey) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if !check_configured(d.client, req, resp) {
+		return
+	}
+	var key SSHKeyModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &key)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data, code, message := get_ssh_key_function(ctx, key.AccountID.ValueInt64())
+	if code != 200 {
+		resp.Diagnostics.AddError(fmt.Sprintf("%d - %s", code, message), "")
+		return
+	}
+	key.KeyType = types.StringValue(data.KeyType)
+	key.PublicKey = types.StringValue(data.PublicKey)
+	key.PrivateKey = types.StringValue(data.PrivateKey)
+	key.Passphrase = types.StringValue(data.Passphrase)
+	key.Certificate = types.StringValue(data.Certificate)
+	key.Fingerprint = types.StringValue(data.Fingerprint)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &key)...)
+}
@@ -0,0 +1,200 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &SSHKeyAssociation{}
+var _ resource.ResourceWithImportState = &SSHKeyAssociation{}
+
+func ssh_key_association() resource.Resource {
+	return &SSHKeyAssociation{}
+}
+
+type SSHKeyAssociation struct {
+	client *http.Client
+}
+
+type SSHKeyAssociationModel struct {
+	AccountID types.Int64    `tfsdk:"account_id"`
+	Hosts     []types.String `tfsdk:"hosts"`
+}
+
+func (r *SSHKeyAssociation) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ssh_key_association"
+}
+
+func (r *SSHKeyAssociation) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Associates a Securden SSH key account with target hosts. Securden deploys the public key to the hosts and removes it when they are dropped from `hosts`.",
+
+		Attributes: map[string]schema.Attribute{
+			"account_id": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "Unique identifier of the SSH key account.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"hosts": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Required:            true,
+				MarkdownDescription: "Host names or IP addresses the key is associated with.",
+			},
+		},
+	}
+}
+
+func (r *SSHKeyAssociation) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*http.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+func (r *SSHKeyAssociation) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !check_resource_configured(r.client, &resp.Diagnostics) {
+		return
+	}
+	var association SSHKeyAssociationModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &association)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	code, message := associate_ssh_key_function(ctx, ssh_key_hosts_params(association.AccountID, host_values(association.Hosts)))
+	if code != 200 {
+		resp.Diagnostics.AddError(fmt.Sprintf("%d - %s", code, message), "")
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &association)...)
+}
+
+func (r *SSHKeyAssociation) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !check_resource_configured(r.client, &resp.Diagnostics) {
+		return
+	}
+	var association SSHKeyAssociationModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &association)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	hosts, code, message := get_ssh_key_hosts_function(ctx, association.AccountID.ValueInt64())
+	if code == 404 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if code != 200 {
+		resp.Diagnostics.AddError(fmt.Sprintf("%d - %s", code, message), "")
+		return
+	}
+	association.Hosts = make([]types.String, 0, len(hosts))
+	for _, host := range hosts {
+		association.Hosts = append(association.Hosts, types.StringValue(host))
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &association)...)
+}
+
+func (r *SSHKeyAssociation) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !check_resource_configured(r.client, &resp.Diagnostics) {
+		return
+	}
+	var association SSHKeyAssociationModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &association)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var state SSHKeyAssociationModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	planned := host_values(association.Hosts)
+	current := host_values(state.Hosts)
+	if added := hosts_difference(planned, current); len(added) > 0 {
+		code, message := associate_ssh_key_function(ctx, ssh_key_hosts_params(association.AccountID, added))
+		if code != 200 {
+			resp.Diagnostics.AddError(fmt.Sprintf("%d - %s", code, message), "")
+			return
+		}
+	}
+	if removed := hosts_difference(current, planned); len(removed) > 0 {
+		code, message := dissociate_ssh_key_function(ctx, ssh_key_hosts_params(association.AccountID, removed))
+		if code != 200 {
+			resp.Diagnostics.AddError(fmt.Sprintf("%d - %s", code, message), "")
+			return
+		}
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &association)...)
+}
+
+func (r *SSHKeyAssociation) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !check_resource_configured(r.client, &resp.Diagnostics) {
+		return
+	}
+	var association SSHKeyAssociationModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &association)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if len(association.Hosts) == 0 {
+		return
+	}
+	code, message := dissociate_ssh_key_function(ctx, ssh_key_hosts_params(association.AccountID, host_values(association.Hosts)))
+	if code != 200 && code != 404 {
+		resp.Diagnostics.AddError(fmt.Sprintf("%d - %s", code, message), "")
+	}
+}
+
+func (r *SSHKeyAssociation) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("Expected a numeric account ID, got: %q", req.ID))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("account_id"), id)...)
+}
+
+func ssh_key_hosts_params(accountID types.Int64, hosts []string) map[string]any {
+	params := make(map[string]any)
+	setParam(params, "account_id", accountID)
+	params["hosts"] = hosts
+	return params
+}
+
+func host_values(hosts []types.String) []string {
+	values := make([]string, 0, len(hosts))
+	for _, host := range hosts {
+		values = append(values, host.ValueString())
+	}
+	return values
+}
+
+// hosts_difference returns the hosts in a that are not in b.
+func hosts_difference(a, b []string) []string {
+	var difference []string
+	for _, host := range a {
+		if !slices.Contains(b, host) {
+			difference = append(difference, host)
+		}
+	}
+	return difference
+}
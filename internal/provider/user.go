@@ -0,0 +1,111 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &User{}
+
+func user() datasource.DataSource {
+	return &User{}
+}
+
+type User struct {
+	client *http.Client
+}
+
+type UserModel struct {
+	ID         types.Int64  `tfsdk:"id"`
+	Username   types.String `tfsdk:"username"`
+	Email      types.String `tfsdk:"email"`
+	Role       types.String `tfsdk:"role"`
+	AuthSource types.String `tfsdk:"auth_source"`
+	Enabled    types.Bool   `tfsdk:"enabled"`
+}
+
+func (d *User) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user"
+}
+
+func (d *User) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Looks up a Securden user by ID or username.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Unique identifier of the user.",
+			},
+			"username": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The login name of the user.",
+			},
+			"email": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The email address of the user.",
+			},
+			"role": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The Securden role assigned to the user.",
+			},
+			"auth_source": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Where the user authenticates, e.g. `local` or the name of an AD domain.",
+			},
+			"enabled": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether the user can log in.",
+			},
+		},
+	}
+}
+
+func (d *User) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*http.Client)
+	if !ok {
+		resp.Diagnostics.AddWarning(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+func (d *User) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if !check_configured(d.client, req, resp) {
+		return
+	}
+	var user UserModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &user)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if user.ID.IsNull() && user.Username.IsNull() {
+		resp.Diagnostics.AddError("Missing User Selector", "Either id or username must be set.")
+		return
+	}
+	data, code, message := get_user_function(ctx, user.ID.ValueInt64(), user.Username.ValueString())
+	if code != 200 {
+		resp.Diagnostics.AddError(fmt.Sprintf("%d - %s", code, message), "")
+		return
+	}
+	user.ID = types.Int64Value(data.ID)
+	user.Username = types.StringValue(data.Username)
+	user.Email = types.StringValue(data.Email)
+	user.Role = types.StringValue(data.Role)
+	user.AuthSource = types.StringValue(data.AuthSource)
+	user.Enabled = types.BoolValue(data.Enabled)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &user)...)
+}
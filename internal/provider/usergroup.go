@@ -0,0 +1,113 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &UserGroup{}
+
+func user_group() datasource.DataSource {
+	return &UserGroup{}
+}
+
+type UserGroup struct {
+	client *http.Client
+}
+
+type UserGroupModel struct {
+	ID          types.Int64    `tfsdk:"id"`
+	Name        types.String   `tfsdk:"name"`
+	Description types.String   `tfsdk:"description"`
+	Members     []types.Int64  `tfsdk:"members"`
+	ADGroups    []types.String `tfsdk:"ad_groups"`
+}
+
+func (d *UserGroup) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_group"
+}
+
+func (d *UserGroup) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Looks up a Securden user group by ID or name.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Unique identifier of the user group.",
+			},
+			"name": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The name of the user group.",
+			},
+			"description": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Description of the user group.",
+			},
+			"members": schema.SetAttribute{
+				ElementType:         types.Int64Type,
+				Computed:            true,
+				MarkdownDescription: "IDs of the users that belong to the group.",
+			},
+			"ad_groups": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Computed:            true,
+				MarkdownDescription: "Active Directory groups mapped to the group.",
+			},
+		},
+	}
+}
+
+func (d *UserGroup) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*http.Client)
+	if !ok {
+		resp.Diagnostics.AddWarning(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+func (d *UserGroup) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if !check_configured(d.client, req, resp) {
+		return
+	}
+	var group UserGroupModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &group)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if group.ID.IsNull() && group.Name.IsNull() {
+		resp.Diagnostics.AddError("Missing User Group Selector", "Either id or name must be set.")
+		return
+	}
+	data, code, message := get_user_group_function(ctx, group.ID.ValueInt64(), group.Name.ValueString())
+	if code != 200 {
+		resp.Diagnostics.AddError(fmt.Sprintf("%d - %s", code, message), "")
+		return
+	}
+	group.ID = types.Int64Value(data.ID)
+	group.Name = types.StringValue(data.Name)
+	group.Description = types.StringValue(data.Description)
+	group.Members = make([]types.Int64, 0, len(data.Members))
+	for _, member := range data.Members {
+		group.Members = append(group.Members, types.Int64Value(member))
+	}
+	group.ADGroups = make([]types.String, 0, len(data.ADGroups))
+	for _, adGroup := range data.ADGroups {
+		group.ADGroups = append(group.ADGroups, types.StringValue(adGroup))
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &group)...)
+}
@@ -0,0 +1,261 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &UserGroupResource{}
+var _ resource.ResourceWithImportState = &UserGroupResource{}
+
+func user_group_resource() resource.Resource {
+	return &UserGroupResource{}
+}
+
+type UserGroupResource struct {
+	client *http.Client
+}
+
+type UserGroupResourceModel struct {
+	ID               types.Int64    `tfsdk:"id"`
+	Name             types.String   `tfsdk:"name"`
+	Description      types.String   `tfsdk:"description"`
+	Members          []types.Int64  `tfsdk:"members"`
+	ADGroups         []types.String `tfsdk:"ad_groups"`
+	EffectiveMembers []types.Int64  `tfsdk:"effective_members"`
+	Reason           types.String   `tfsdk:"reason"`
+}
+
+func (r *UserGroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_group"
+}
+
+func (r *UserGroupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a user group in Securden.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Unique identifier of the user group.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the user group.",
+			},
+			"description": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Description of the user group.",
+			},
+			"members": schema.SetAttribute{
+				ElementType:         types.Int64Type,
+				Optional:            true,
+				MarkdownDescription: "IDs of the users added to the group explicitly. Users synchronized from `ad_groups` are not listed here.",
+			},
+			"ad_groups": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Active Directory groups whose members are synchronized into the group, e.g. `CN=DBAs,OU=Groups,DC=acme,DC=com`.",
+			},
+			"reason": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Reason recorded in the Securden audit trail for the changes Terraform makes to the user group. Overrides the provider's `default_reason`.",
+			},
+			"effective_members": schema.SetAttribute{
+				ElementType:         types.Int64Type,
+				Computed:            true,
+				MarkdownDescription: "IDs of all users in the group, including the users synchronized from `ad_groups`.",
+			},
+		},
+	}
+}
+
+func (r *UserGroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*http.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+func (r *UserGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !check_resource_configured(r.client, &resp.Diagnostics) {
+		return
+	}
+	var group UserGroupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &group)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	params := user_group_params(group)
+	id, code, message := add_user_group_function(ctx, params)
+	if code != 200 {
+		resp.Diagnostics.AddError(fmt.Sprintf("%d - %s", code, message), "")
+		return
+	}
+	group.ID = types.Int64Value(id)
+	refresh_effective_members(ctx, &group)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &group)...)
+}
+
+func (r *UserGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !check_resource_configured(r.client, &resp.Diagnostics) {
+		return
+	}
+	var group UserGroupResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &group)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data, code, message := get_user_group_function(ctx, group.ID.ValueInt64(), "")
+	if code == 404 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if code != 200 {
+		resp.Diagnostics.AddError(fmt.Sprintf("%d - %s", code, message), "")
+		return
+	}
+	group.Name = types.StringValue(data.Name)
+	if data.Description != "" || !group.Description.IsNull() {
+		group.Description = types.StringValue(data.Description)
+	}
+	group.EffectiveMembers = int64_values(data.Members)
+	if len(data.ADGroups) > 0 {
+		// The server reports synchronized users as members too; only keep the
+		// explicit members that are still in the group.
+		group.Members = explicit_members(group.Members, data.Members)
+	} else if len(data.Members) > 0 || group.Members != nil {
+		group.Members = int64_values(data.Members)
+	}
+	if len(data.ADGroups) > 0 || group.ADGroups != nil {
+		group.ADGroups = make([]types.String, 0, len(data.ADGroups))
+		for _, adGroup := range data.ADGroups {
+			group.ADGroups = append(group.ADGroups, types.StringValue(adGroup))
+		}
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &group)...)
+}
+
+func (r *UserGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !check_resource_configured(r.client, &resp.Diagnostics) {
+		return
+	}
+	var group UserGroupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &group)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	params := user_group_params(group)
+	setParam(params, "user_group_id", group.ID)
+	params["description"] = group.Description.ValueString()
+	code, message := edit_user_group_function(ctx, params)
+	if code != 200 {
+		resp.Diagnostics.AddError(fmt.Sprintf("%d - %s", code, message), "")
+		return
+	}
+	refresh_effective_members(ctx, &group)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &group)...)
+}
+
+func (r *UserGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !check_resource_configured(r.client, &resp.Diagnostics) {
+		return
+	}
+	var group UserGroupResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &group)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	params := make(map[string]any)
+	setParam(params, "user_group_id", group.ID)
+	setParam(params, "reason", group.Reason)
+	code, message := delete_user_group_function(ctx, params)
+	if code != 200 && code != 404 {
+		resp.Diagnostics.AddError(fmt.Sprintf("%d - %s", code, message), "")
+	}
+}
+
+func (r *UserGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("Expected a numeric user group ID, got: %q", req.ID))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// refresh_effective_members reads back the members of the group after it has
+// been saved. When the read fails the explicit members are used instead.
+func refresh_effective_members(ctx context.Context, group *UserGroupResourceModel) {
+	data, code, message := get_user_group_function(ctx, group.ID.ValueInt64(), "")
+	if code != 200 {
+		tflog.Warn(ctx, "Unable to read back the user group members", map[string]any{"status_code": code, "message": message})
+		group.EffectiveMembers = append([]types.Int64{}, group.Members...)
+		return
+	}
+	group.EffectiveMembers = int64_values(data.Members)
+}
+
+// explicit_members returns the members of state that are also in current.
+func explicit_members(state []types.Int64, current []int64) []types.Int64 {
+	if state == nil {
+		return nil
+	}
+	members := make([]types.Int64, 0, len(state))
+	for _, member := range state {
+		if slices.Contains(current, member.ValueInt64()) {
+			members = append(members, member)
+		}
+	}
+	return members
+}
+
+func int64_values(values []int64) []types.Int64 {
+	result := make([]types.Int64, 0, len(values))
+	for _, value := range values {
+		result = append(result, types.Int64Value(value))
+	}
+	return result
+}
+
+// user_group_params builds the request body shared by create and update. Members
+// and AD groups are always sent so that removing the last entry clears the list.
+func user_group_params(group UserGroupResourceModel) map[string]any {
+	params := make(map[string]any)
+	setParam(params, "user_group_name", group.Name)
+	setParam(params, "description", group.Description)
+	setParam(params, "reason", group.Reason)
+	members := []int64{}
+	for _, member := range group.Members {
+		members = append(members, member.ValueInt64())
+	}
+	params["members"] = members
+	adGroups := []string{}
+	for _, adGroup := range group.ADGroups {
+		adGroups = append(adGroups, adGroup.ValueString())
+	}
+	params["ad_groups"] = adGroups
+	return params
+}
@@ -0,0 +1,215 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &UserResource{}
+var _ resource.ResourceWithImportState = &UserResource{}
+
+func user_resource() resource.Resource {
+	return &UserResource{}
+}
+
+type UserResource struct {
+	client *http.Client
+}
+
+type UserResourceModel struct {
+	ID         types.Int64  `tfsdk:"id"`
+	Username   types.String `tfsdk:"username"`
+	Email      types.String `tfsdk:"email"`
+	Role       types.String `tfsdk:"role"`
+	AuthSource types.String `tfsdk:"auth_source"`
+	Enabled    types.Bool   `tfsdk:"enabled"`
+	Reason     types.String `tfsdk:"reason"`
+}
+
+func (r *UserResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user"
+}
+
+func (r *UserResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a user in Securden.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Unique identifier of the user.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"username": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The login name of the user. Changing it creates a new user.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"email": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The email address of the user.",
+			},
+			"role": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The Securden role assigned to the user, e.g. `Administrator` or `User`.",
+			},
+			"auth_source": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Where the user authenticates, e.g. `local` or the name of an AD domain. Changing it creates a new user.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				MarkdownDescription: "Whether the user can log in. Defaults to true.",
+			},
+			"reason": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Reason recorded in the Securden audit trail for the changes Terraform makes to the user. Overrides the provider's `default_reason`.",
+			},
+		},
+	}
+}
+
+func (r *UserResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*http.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+func (r *UserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !check_resource_configured(r.client, &resp.Diagnostics) {
+		return
+	}
+	var user UserResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &user)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	params := make(map[string]any)
+	setParam(params, "username", user.Username)
+	setParam(params, "email", user.Email)
+	setParam(params, "role", user.Role)
+	setParam(params, "auth_source", user.AuthSource)
+	setParam(params, "enabled", user.Enabled)
+	setParam(params, "reason", user.Reason)
+	id, code, message := add_user_function(ctx, params)
+	if code != 200 {
+		resp.Diagnostics.AddError(fmt.Sprintf("%d - %s", code, message), "")
+		return
+	}
+	user.ID = types.Int64Value(id)
+	data, code, message := get_user_function(ctx, id, "")
+	if code != 200 {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &user)...)
+		resp.Diagnostics.AddError(fmt.Sprintf("%d - %s", code, message), "")
+		return
+	}
+	user.AuthSource = types.StringValue(data.AuthSource)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &user)...)
+}
+
+func (r *UserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !check_resource_configured(r.client, &resp.Diagnostics) {
+		return
+	}
+	var user UserResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &user)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data, code, message := get_user_function(ctx, user.ID.ValueInt64(), "")
+	if code == 404 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if code != 200 {
+		resp.Diagnostics.AddError(fmt.Sprintf("%d - %s", code, message), "")
+		return
+	}
+	user.Username = types.StringValue(data.Username)
+	user.Email = types.StringValue(data.Email)
+	user.Role = types.StringValue(data.Role)
+	user.AuthSource = types.StringValue(data.AuthSource)
+	user.Enabled = types.BoolValue(data.Enabled)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &user)...)
+}
+
+func (r *UserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !check_resource_configured(r.client, &resp.Diagnostics) {
+		return
+	}
+	var user UserResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &user)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	params := make(map[string]any)
+	setParam(params, "user_id", user.ID)
+	setParam(params, "email", user.Email)
+	setParam(params, "role", user.Role)
+	setParam(params, "enabled", user.Enabled)
+	setParam(params, "reason", user.Reason)
+	code, message := edit_user_function(ctx, params)
+	if code != 200 {
+		resp.Diagnostics.AddError(fmt.Sprintf("%d - %s", code, message), "")
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &user)...)
+}
+
+func (r *UserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !check_resource_configured(r.client, &resp.Diagnostics) {
+		return
+	}
+	var user UserResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &user)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	params := make(map[string]any)
+	setParam(params, "user_id", user.ID)
+	setParam(params, "reason", user.Reason)
+	code, message := delete_user_function(ctx, params)
+	if code != 200 && code != 404 {
+		resp.Diagnostics.AddError(fmt.Sprintf("%d - %s", code, message), "")
+	}
+}
+
+func (r *UserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("Expected a numeric user ID, got: %q", req.ID))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}